@@ -1,7 +1,9 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	apic "github.com/imfact-labs/currency-model/api"
 	ctypes "github.com/imfact-labs/currency-model/types"
@@ -12,11 +14,19 @@ import (
 )
 
 var (
-	HandlerPathDAOService        = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}`
-	HandlerPathDAOProposal       = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}`
-	HandlerPathDAODelegator      = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/registrant/{address:(?i)` + ctypes.REStringAddressString + `}`
-	HandlerPathDAOVoters         = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/voter`
-	HandlerPathDAOVotingPowerBox = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/votingpower` // revive:disable-line:line-length-limit
+	HandlerPathDAOService          = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}`
+	HandlerPathDAOProposal         = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}`
+	HandlerPathDAOProposals        = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposals`
+	HandlerPathDAODelegator        = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/registrant/{address:(?i)` + ctypes.REStringAddressString + `}`
+	HandlerPathDAOVoters           = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/voter`
+	HandlerPathDAOVotingPowerBox   = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/votingpower` // revive:disable-line:line-length-limit
+	HandlerPathDAOProposalTimeline = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/timeline`    // revive:disable-line:line-length-limit
+	HandlerPathDAOTally            = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/tally`       // revive:disable-line:line-length-limit
+	HandlerPathDAOSponsors         = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/proposal/{proposal_id:` + ctypes.ReSpecialCh + `}/sponsors`    // revive:disable-line:line-length-limit
+	HandlerPathDAOWhitelist        = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/whitelist`
+	HandlerPathDAOVoterAllowlist   = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/voter-allowlist`
+	HandlerPathDAORoles            = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/roles/{address:(?i)` + ctypes.REStringAddressString + `}`
+	HandlerPathDAOPolicy           = `/dao/{contract:(?i)` + ctypes.REStringAddressString + `}/policy/{version:[0-9]+}`
 )
 
 func SetHandlers(hd *apic.Handlers) {
@@ -25,12 +35,28 @@ func SetHandlers(hd *apic.Handlers) {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.SetHandler(HandlerPathDAOProposal, HandleDAOProposal, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOProposals, HandleDAOProposals, true, get, get).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.SetHandler(HandlerPathDAODelegator, HandleDAODelegator, true, get, get).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.SetHandler(HandlerPathDAOVoters, HandleDAOVoters, true, get, get).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.SetHandler(HandlerPathDAOVotingPowerBox, HandleDAOVotingPowerBox, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOProposalTimeline, HandleDAOProposalTimeline, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOTally, HandleDAOTally, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOSponsors, HandleDAOSponsors, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOWhitelist, HandleDAOWhitelist, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOVoterAllowlist, HandleDAOVoterAllowlist, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAORoles, HandleDAORoles, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathDAOPolicy, HandleDAOPolicy, true, get, get).
+		Methods(http.MethodOptions, "GET")
 }
 
 func HandleDAOService(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
@@ -141,6 +167,90 @@ func buildDAOProposalHal(hd *apic.Handlers, contract, proposalID string, proposa
 	return hal, nil
 }
 
+func HandleDAOProposals(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	var offset uint64
+	if s := apic.ParseStringQuery(r.URL.Query().Get("offset")); len(s) > 0 {
+		if offset, err = strconv.ParseUint(s, 10, 64); err != nil {
+			apic.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	limit := apic.ParseLimitQuery(r.URL.Query().Get("limit"))
+	if limit < 0 {
+		limit = hd.ItemsLimiter("dao-proposals")
+	}
+
+	cacheKey := apic.CacheKey(r.URL.Path, fmt.Sprintf("offset=%d", offset), fmt.Sprintf("limit=%d", limit))
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOProposalsInGroup(hd, contract, offset, limit)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOProposalsInGroup(hd *apic.Handlers, contract string, offset uint64, limit int64) (interface{}, error) {
+	proposals, err := digest.DAOProposals(hd.Database(), contract, offset, limit)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "proposals, contract %s", contract)
+	}
+
+	hal, err := buildDAOProposalsHal(hd, contract, offset, limit, proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.Encoder().Marshal(hal)
+}
+
+func buildDAOProposalsHal(hd *apic.Handlers,
+	contract string, offset uint64, limit int64, proposals []state.ProposalStateValue,
+) (apic.Hal, error) {
+	if len(proposals) < 1 {
+		return apic.NewEmptyHal(), nil
+	}
+
+	items := make([]apic.Hal, len(proposals))
+	for i := range proposals {
+		hal, err := buildDAOProposalHal(
+			hd, contract, strconv.FormatUint(proposals[i].Sequence(), 10), proposals[i])
+		if err != nil {
+			return nil, err
+		}
+		items[i] = hal
+	}
+
+	h, err := hd.CombineURL(HandlerPathDAOProposals, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal apic.Hal
+	hal = apic.NewBaseHal(items, apic.NewHalLink(apic.AddQueryValue(h, fmt.Sprintf("offset=%d", offset)), nil))
+
+	if int64(len(proposals)) == limit {
+		next := proposals[len(proposals)-1].Sequence()
+		hal = hal.AddLink("next", apic.NewHalLink(apic.AddQueryValue(h, fmt.Sprintf("offset=%d", next)), nil))
+	}
+
+	return hal, nil
+}
+
 func HandleDAODelegator(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
 	cacheKey := apic.CacheKeyPath(r)
 	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
@@ -332,3 +442,435 @@ func buildDAOVotingPowerBoxHal(hd *apic.Handlers,
 
 	return hal, nil
 }
+
+func HandleDAOProposalTimeline(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cacheKey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	proposalID, err, status := apic.ParseRequest(w, r, "proposal_id")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOProposalTimelineInGroup(hd, contract, proposalID)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOProposalTimelineInGroup(hd *apic.Handlers, contract, proposalID string) (interface{}, error) {
+	switch transitions, err := digest.DAOStatusHistory(hd.Database(), contract, proposalID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "status history, contract %s, proposalID %s", contract, proposalID)
+	case transitions == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("status history, contract %s, proposalID %s", contract, proposalID)
+	default:
+		hal, err := buildDAOProposalTimelineHal(hd, contract, proposalID, transitions)
+		if err != nil {
+			return nil, err
+		}
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func buildDAOProposalTimelineHal(hd *apic.Handlers,
+	contract, proposalID string, transitions []types.StatusTransition,
+) (apic.Hal, error) {
+	h, err := hd.CombineURL(HandlerPathDAOProposalTimeline, "contract", contract, "proposal_id", proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := apic.NewBaseHal(transitions, apic.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func HandleDAOTally(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cacheKey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	proposalID, err, status := apic.ParseRequest(w, r, "proposal_id")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOTallyInGroup(hd, contract, proposalID)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOTallyInGroup(hd *apic.Handlers, contract, proposalID string) (interface{}, error) {
+	switch tally, err := digest.DAOTally(hd.Database(), contract, proposalID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "tally, contract %s, proposalID %s", contract, proposalID)
+	case tally == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("tally, contract %s, proposalID %s", contract, proposalID)
+	default:
+		hal, err := buildDAOTallyHal(hd, contract, proposalID, *tally)
+		if err != nil {
+			return nil, err
+		}
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func buildDAOTallyHal(hd *apic.Handlers,
+	contract, proposalID string, tally types.Tally,
+) (apic.Hal, error) {
+	h, err := hd.CombineURL(HandlerPathDAOTally, "contract", contract, "proposal_id", proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := apic.NewBaseHal(tally, apic.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func HandleDAOSponsors(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cacheKey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	proposalID, err, status := apic.ParseRequest(w, r, "proposal_id")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOSponsorsInGroup(hd, contract, proposalID)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOSponsorsInGroup(hd *apic.Handlers, contract, proposalID string) (interface{}, error) {
+	switch sponsors, err := digest.DAOSponsors(hd.Database(), contract, proposalID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "sponsors, contract %s, proposalID %s", contract, proposalID)
+	case sponsors == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("sponsors, contract %s, proposalID %s", contract, proposalID)
+	default:
+		hal, err := buildDAOSponsorsHal(hd, contract, proposalID, sponsors)
+		if err != nil {
+			return nil, err
+		}
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func buildDAOSponsorsHal(hd *apic.Handlers,
+	contract, proposalID string, sponsors []types.SponsorInfo,
+) (apic.Hal, error) {
+	h, err := hd.CombineURL(HandlerPathDAOSponsors, "contract", contract, "proposal_id", proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := apic.NewBaseHal(sponsors, apic.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func HandleDAOWhitelist(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	offset := apic.ParseStringQuery(r.URL.Query().Get("offset"))
+
+	limit := apic.ParseLimitQuery(r.URL.Query().Get("limit"))
+	if limit < 0 {
+		limit = hd.ItemsLimiter("dao-whitelist")
+	}
+
+	cacheKey := apic.CacheKey(r.URL.Path, fmt.Sprintf("offset=%s", offset), fmt.Sprintf("limit=%d", limit))
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOWhitelistInGroup(hd, contract, offset, limit)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOWhitelistInGroup(hd *apic.Handlers, contract, offset string, limit int64) (interface{}, error) {
+	members, err := digest.DAOWhitelist(hd.Database(), contract, offset, limit)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "whitelist, contract %s", contract)
+	}
+
+	hal, err := buildDAOWhitelistHal(hd, contract, offset, limit, members)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.Encoder().Marshal(hal)
+}
+
+func buildDAOWhitelistHal(hd *apic.Handlers,
+	contract, offset string, limit int64, members []state.WhitelistMemberStateValue,
+) (apic.Hal, error) {
+	if len(members) < 1 {
+		return apic.NewEmptyHal(), nil
+	}
+
+	h, err := hd.CombineURL(HandlerPathDAOWhitelist, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal apic.Hal
+	hal = apic.NewBaseHal(members, apic.NewHalLink(apic.AddQueryValue(h, fmt.Sprintf("offset=%s", offset)), nil))
+
+	if int64(len(members)) == limit {
+		next := members[len(members)-1].Account().String()
+		hal = hal.AddLink("next", apic.NewHalLink(apic.AddQueryValue(h, fmt.Sprintf("offset=%s", next)), nil))
+	}
+
+	return hal, nil
+}
+
+func HandleDAOVoterAllowlist(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	offset := apic.ParseStringQuery(r.URL.Query().Get("offset"))
+
+	limit := apic.ParseLimitQuery(r.URL.Query().Get("limit"))
+	if limit < 0 {
+		limit = hd.ItemsLimiter("dao-voter-allowlist")
+	}
+
+	cacheKey := apic.CacheKey(r.URL.Path, fmt.Sprintf("offset=%s", offset), fmt.Sprintf("limit=%d", limit))
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOVoterAllowlistInGroup(hd, contract, offset, limit)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOVoterAllowlistInGroup(hd *apic.Handlers, contract, offset string, limit int64) (interface{}, error) {
+	members, err := digest.DAOVoterAllowlist(hd.Database(), contract, offset, limit)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "voter allowlist, contract %s", contract)
+	}
+
+	hal, err := buildDAOVoterAllowlistHal(hd, contract, offset, limit, members)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.Encoder().Marshal(hal)
+}
+
+func buildDAOVoterAllowlistHal(hd *apic.Handlers,
+	contract, offset string, limit int64, members []state.VoterAllowlistMemberStateValue,
+) (apic.Hal, error) {
+	if len(members) < 1 {
+		return apic.NewEmptyHal(), nil
+	}
+
+	h, err := hd.CombineURL(HandlerPathDAOVoterAllowlist, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal apic.Hal
+	hal = apic.NewBaseHal(members, apic.NewHalLink(apic.AddQueryValue(h, fmt.Sprintf("offset=%s", offset)), nil))
+
+	if int64(len(members)) == limit {
+		next := members[len(members)-1].Account().String()
+		hal = hal.AddLink("next", apic.NewHalLink(apic.AddQueryValue(h, fmt.Sprintf("offset=%s", next)), nil))
+	}
+
+	return hal, nil
+}
+
+func HandleDAORoles(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cacheKey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	account, err, status := apic.ParseRequest(w, r, "address")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAORolesInGroup(hd, contract, account)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAORolesInGroup(hd *apic.Handlers, contract, account string) (interface{}, error) {
+	switch roles, err := digest.DAORoles(hd.Database(), contract, account); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "roles, contract %s, account %s", contract, account)
+	case roles == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("roles, contract %s, account %s", contract, account)
+	default:
+		hal, err := buildDAORolesHal(hd, contract, account, *roles)
+		if err != nil {
+			return nil, err
+		}
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func buildDAORolesHal(hd *apic.Handlers,
+	contract, account string, roles state.RolesStateValue,
+) (apic.Hal, error) {
+	h, err := hd.CombineURL(HandlerPathDAORoles, "contract", contract, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := apic.NewBaseHal(roles, apic.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func HandleDAOPolicy(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cacheKey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	s, err, status := apic.ParseRequest(w, r, "version")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	version, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cacheKey, func() (interface{}, error) {
+		return handleDAOPolicyInGroup(hd, contract, version)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cacheKey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleDAOPolicyInGroup(hd *apic.Handlers, contract string, version uint64) (interface{}, error) {
+	switch design, err := digest.DAOPolicy(hd.Database(), contract, version); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "dao policy, contract %s, version %d", contract, version)
+	case design == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("dao policy, contract %s, version %d", contract, version)
+	default:
+		hal, err := buildDAOPolicyHal(hd, contract, version, *design)
+		if err != nil {
+			return nil, err
+		}
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func buildDAOPolicyHal(hd *apic.Handlers,
+	contract string, version uint64, design state.DesignStateValue,
+) (apic.Hal, error) {
+	h, err := hd.CombineURL(HandlerPathDAOPolicy, "contract", contract, "version", strconv.FormatUint(version, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	hal := apic.NewBaseHal(design, apic.NewHalLink(h, nil))
+
+	return hal, nil
+}
@@ -0,0 +1,92 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/dao-model/operation/dao"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type AddWhitelistCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Account  []ccmds.AddressFlag  `name:"account" help:"account to be added to whitelist" required:"true"`
+	sender   base.Address
+	contract base.Address
+	accounts []base.Address
+}
+
+func (cmd *AddWhitelistCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *AddWhitelistCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	accounts := make([]base.Address, len(cmd.Account))
+	for i := range cmd.Account {
+		a, err := cmd.Account[i].Encode(cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid whitelist account format, %q", cmd.Account[i].String())
+		}
+		accounts[i] = a
+	}
+	cmd.accounts = accounts
+
+	return nil
+}
+
+func (cmd *AddWhitelistCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create add whitelist operation")
+
+	fact := dao.NewAddWhitelistFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.accounts,
+		cmd.Currency.CID,
+	)
+
+	op := dao.NewAddWhitelist(fact)
+	err := op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,98 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/dao-model/operation/dao"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type AmendProposalCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender     ccmds.AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   ccmds.AddressFlag `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string            `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Option     types.DAOOption   `arg:"" name:"option" help:"propose option; crypto | biz" required:"true"`
+	StartTime  uint64            `arg:"" name:"start-time" help:"start time to proposal lifecycle; block height if dao periods are in heights" required:"true"`
+	Dependency []string          `name:"dependency" help:"prerequisite proposal id; repeatable"`
+	CryptoProposalCommand
+	BizProposalCommand
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+	proposal types.Proposal
+}
+
+func (cmd *AmendProposalCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *AmendProposalCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	proposal, err := newProposal(
+		cmd.Encoders.JSON(), sender, cmd.Option, cmd.StartTime, cmd.Dependency,
+		cmd.CryptoProposalCommand, cmd.BizProposalCommand,
+	)
+	if err != nil {
+		return err
+	}
+	cmd.proposal = proposal
+
+	return nil
+}
+
+func (cmd *AmendProposalCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create amend proposal operation")
+
+	fact := dao.NewAmendProposalFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		cmd.proposal,
+		cmd.Currency.CID,
+	)
+
+	op := dao.NewAmendProposal(fact)
+	err := op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -1,13 +1,25 @@
 package cmds
 
 type DAOCommand struct {
-	CreateDAO      RegisterModelCommand     `cmd:"" name:"create-dao" help:"create dao to contract account"`
-	UpdatePolicy   UpdateModelConfigCommand `cmd:"" name:"update-policy" help:"update dao policy"`
-	Propose        ProposeCommand           `cmd:"" name:"propose" help:"propose new proposal"`
-	CancelProposal CancelProposalCommand    `cmd:"" name:"cancel-proposal" help:"cancel proposal"`
-	Register       RegisterCommand          `cmd:"" name:"register" help:"register to vote"`
-	PreSnap        PreSnapCommand           `cmd:"" name:"pre-snap" help:"snap voting powers"`
-	Vote           VoteCommand              `cmd:"" name:"vote" help:"vote to proposal"`
-	PostSnap       PostSnapCommand          `cmd:"" name:"post-snap" help:"snap voting powers"`
-	Execute        ExecuteCommand           `cmd:"" name:"execute" help:"execute proposal"`
+	CreateDAO            RegisterModelCommand        `cmd:"" name:"create-dao" help:"create dao to contract account"`
+	UpdatePolicy         UpdateModelConfigCommand    `cmd:"" name:"update-policy" help:"update dao policy"`
+	AddWhitelist         AddWhitelistCommand         `cmd:"" name:"add-whitelist" help:"add accounts to dao whitelist"`
+	RemoveWhitelist      RemoveWhitelistCommand      `cmd:"" name:"remove-whitelist" help:"remove accounts from dao whitelist"`
+	AddVoterAllowlist    AddVoterAllowlistCommand    `cmd:"" name:"add-voter-allowlist" help:"add accounts to dao voter allowlist"`
+	RemoveVoterAllowlist RemoveVoterAllowlistCommand `cmd:"" name:"remove-voter-allowlist" help:"remove accounts from dao voter allowlist"`
+	GrantRole            GrantRoleCommand            `cmd:"" name:"grant-role" help:"grant dao role to account"`
+	RevokeRole           RevokeRoleCommand           `cmd:"" name:"revoke-role" help:"revoke dao role from account"`
+	RenounceAdmin        RenounceAdminCommand        `cmd:"" name:"renounce-admin" help:"renounce admin policy updates of dao"`
+	Propose              ProposeCommand              `cmd:"" name:"propose" help:"propose new proposal"`
+	AmendProposal        AmendProposalCommand        `cmd:"" name:"amend-proposal" help:"amend proposal during review period"`
+	CancelProposal       CancelProposalCommand       `cmd:"" name:"cancel-proposal" help:"cancel proposal"`
+	Sponsor              SponsorCommand              `cmd:"" name:"sponsor" help:"sponsor proposal by whitelisted account"`
+	Reject               RejectCommand               `cmd:"" name:"reject" help:"reject proposal during review period by reviewer"`
+	Deposit              DepositCommand              `cmd:"" name:"deposit" help:"add to proposal deposit"`
+	Register             RegisterCommand             `cmd:"" name:"register" help:"register to vote"`
+	PreSnap              PreSnapCommand              `cmd:"" name:"pre-snap" help:"snap voting powers"`
+	Vote                 VoteCommand                 `cmd:"" name:"vote" help:"vote to proposal"`
+	PostSnap             PostSnapCommand             `cmd:"" name:"post-snap" help:"snap voting powers"`
+	Veto                 VetoCommand                 `cmd:"" name:"veto" help:"veto completed proposal by guardian"`
+	Execute              ExecuteCommand              `cmd:"" name:"execute" help:"execute proposal"`
 }
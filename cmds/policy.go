@@ -0,0 +1,386 @@
+package cmds
+
+import (
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type DepositRuleFlags struct {
+	DepositOnCompleted string `name:"deposit-on-completed" help:"deposit action on completed proposal; refund | keep | burn" default:"keep"`
+	DepositOnRejected  string `name:"deposit-on-rejected" help:"deposit action on rejected proposal; refund | keep | burn" default:"keep"`
+	DepositOnCanceled  string `name:"deposit-on-canceled" help:"deposit action on canceled proposal; refund | keep | burn" default:"keep"`
+	DepositOnWithdrawn string `name:"deposit-on-withdrawn" help:"deposit action on proposal canceled by proposer; refund | keep | burn" default:"keep"`
+}
+
+func (f DepositRuleFlags) DepositRule() (types.DepositRule, error) {
+	names := []string{f.DepositOnCompleted, f.DepositOnRejected, f.DepositOnCanceled, f.DepositOnWithdrawn}
+	actions := make([]types.DepositAction, len(names))
+
+	for i, name := range names {
+		a, err := types.DepositActionFromString(name)
+		if err != nil {
+			return types.DepositRule{}, errors.Wrap(err, "invalid deposit rule")
+		}
+		actions[i] = a
+	}
+
+	return types.NewDepositRule(actions[0], actions[1], actions[2], actions[3]), nil
+}
+
+type CrowdfundFlags struct {
+	DepositPeriod uint64        `name:"deposit-period" help:"period to collect proposal deposits; 0 disables crowdfunded deposits" default:"0"`
+	DepositTarget ccmds.BigFlag `name:"deposit-target" help:"deposit amount in proposal fee currency to move proposal into review" default:"0"`
+}
+
+type OptimisticFlags struct {
+	ObjectionThreshold uint `name:"objection-threshold" help:"percent of total supply disapproval votes must exceed to reject optimistic proposal; 0 disables optimistic proposals" default:"0"`
+}
+
+type ExecutionFlags struct {
+	ExecutionGracePeriod uint64 `name:"execution-grace-period" help:"period to execute completed proposal after execution delay; 0 never expires" default:"0"`
+	ExecutionRetries     uint64 `name:"execution-retries" help:"number of times failed execution can be retried; 0 disables retry" default:"0"`
+}
+
+type SponsorFlags struct {
+	SponsorsRequired uint64 `name:"sponsors-required" help:"number of whitelisted sponsors a proposal from non-whitelisted account needs; 0 disables sponsorship" default:"0"`
+}
+
+type GuardianFlags struct {
+	Guardian              []ccmds.AddressFlag `name:"guardian" help:"guardian account which can veto completed security-critical proposals"`
+	GuardianThreshold     uint                `name:"guardian-threshold" help:"number of guardian vetoes to block execution" default:"0"`
+	GuardianTransferLimit ccmds.BigFlag       `name:"guardian-transfer-limit" help:"transfer amount from which guardians can veto the proposal" default:"0"`
+}
+
+func (f GuardianFlags) Guardians(enc encoder.Encoder) (types.Guardians, error) {
+	accounts := make([]base.Address, len(f.Guardian))
+
+	for i := range f.Guardian {
+		a, err := f.Guardian[i].Encode(enc)
+		if err != nil {
+			return types.Guardians{}, errors.Wrapf(err, "invalid guardian account format, %q", f.Guardian[i].String())
+		}
+		accounts[i] = a
+	}
+
+	return types.NewGuardians(accounts, f.GuardianThreshold, f.GuardianTransferLimit.Big), nil
+}
+
+type ReviewerFlags struct {
+	Reviewer []ccmds.AddressFlag `name:"reviewer" help:"reviewer account which can reject proposals during review period"`
+}
+
+func (f ReviewerFlags) Reviewers(enc encoder.Encoder) (types.Reviewers, error) {
+	accounts := make([]base.Address, len(f.Reviewer))
+
+	for i := range f.Reviewer {
+		a, err := f.Reviewer[i].Encode(enc)
+		if err != nil {
+			return types.Reviewers{}, errors.Wrapf(err, "invalid reviewer account format, %q", f.Reviewer[i].String())
+		}
+		accounts[i] = a
+	}
+
+	return types.NewReviewers(accounts), nil
+}
+
+type ExecutorFlags struct {
+	Executor []ccmds.AddressFlag `name:"executor" help:"executor account which alone can pre-snap, post-snap and execute proposals"`
+}
+
+func (f ExecutorFlags) Executors(enc encoder.Encoder) (types.Executors, error) {
+	accounts, err := encodeAddressFlags(enc, f.Executor, "executor")
+	if err != nil {
+		return types.Executors{}, err
+	}
+
+	return types.NewExecutors(accounts), nil
+}
+
+type VoterAllowlistFlags struct {
+	VoterAllowlist bool `name:"voter-allowlist" help:"only accounts in voter allowlist can register and vote"`
+}
+
+type PeriodUnitFlags struct {
+	PeriodUnit string `name:"period-unit" help:"unit of lifecycle periods and proposal start time; second | height" default:"second"`
+}
+
+type LeadTimeFlags struct {
+	MinLeadTime uint64 `name:"min-lead-time" help:"min time from proposal submission to its start time" default:"0"`
+	MaxLeadTime uint64 `name:"max-lead-time" help:"max time from proposal submission to its start time; 0 disables max" default:"0"`
+}
+
+type ActiveProposalFlags struct {
+	MaxActiveProposals   uint64 `name:"max-active-proposals" help:"max number of proposals of the dao which have not ended; 0 disables max" default:"0"`
+	MaxProposerProposals uint64 `name:"max-proposer-proposals" help:"max number of proposals of a proposer which have not ended; 0 disables max" default:"0"`
+}
+
+// PolicyBoundsFlags are the guard rails of the dao policy set on registration; a zero bound is not checked.
+type PolicyBoundsFlags struct {
+	MinProposalReviewPeriod uint64                    `name:"min-proposal-review-period" help:"min bound of proposal review period" default:"0"`
+	MaxProposalReviewPeriod uint64                    `name:"max-proposal-review-period" help:"max bound of proposal review period" default:"0"`
+	MinRegistrationPeriod   uint64                    `name:"min-registration-period" help:"min bound of registration period" default:"0"`
+	MaxRegistrationPeriod   uint64                    `name:"max-registration-period" help:"max bound of registration period" default:"0"`
+	MinPreSnapshotPeriod    uint64                    `name:"min-pre-snapshot-period" help:"min bound of pre snapshot period" default:"0"`
+	MaxPreSnapshotPeriod    uint64                    `name:"max-pre-snapshot-period" help:"max bound of pre snapshot period" default:"0"`
+	MinVotingPeriod         uint64                    `name:"min-voting-period" help:"min bound of voting period" default:"0"`
+	MaxVotingPeriod         uint64                    `name:"max-voting-period" help:"max bound of voting period" default:"0"`
+	MinPostSnapshotPeriod   uint64                    `name:"min-post-snapshot-period" help:"min bound of post snapshot period" default:"0"`
+	MaxPostSnapshotPeriod   uint64                    `name:"max-post-snapshot-period" help:"max bound of post snapshot period" default:"0"`
+	MinExecutionDelayPeriod uint64                    `name:"min-execution-delay-period" help:"min bound of execution delay period" default:"0"`
+	MaxExecutionDelayPeriod uint64                    `name:"max-execution-delay-period" help:"max bound of execution delay period" default:"0"`
+	MinTurnout              uint                      `name:"min-turnout" help:"min bound of turnout" default:"0"`
+	MaxTurnout              uint                      `name:"max-turnout" help:"max bound of turnout" default:"0"`
+	MinQuorum               uint                      `name:"min-quorum" help:"min bound of quorum" default:"0"`
+	MaxQuorum               uint                      `name:"max-quorum" help:"max bound of quorum" default:"0"`
+	MinThreshold            ccmds.BigFlag             `name:"min-threshold" help:"min bound of threshold" default:"0"`
+	MaxThreshold            ccmds.BigFlag             `name:"max-threshold" help:"max bound of threshold" default:"0"`
+	MinProposalFee          *ccmds.CurrencyAmountFlag `name:"min-proposal-fee" help:"min bound of proposal fee"`
+	MinObjectionThreshold   uint                      `name:"min-objection-threshold" help:"min bound of objection threshold" default:"0"`
+	MaxObjectionThreshold   uint                      `name:"max-objection-threshold" help:"max bound of objection threshold" default:"0"`
+	MinExecutionGracePeriod uint64                    `name:"min-execution-grace-period" help:"min bound of execution grace period" default:"0"`
+	MaxExecutionGracePeriod uint64                    `name:"max-execution-grace-period" help:"max bound of execution grace period" default:"0"`
+	MinGuardians            uint64                    `name:"min-guardians" help:"min bound of guardian accounts" default:"0"`
+	MaxGuardians            uint64                    `name:"max-guardians" help:"max bound of guardian accounts" default:"0"`
+	MinExecutors            uint64                    `name:"min-executors" help:"min bound of executor accounts" default:"0"`
+	MaxExecutors            uint64                    `name:"max-executors" help:"max bound of executor accounts" default:"0"`
+}
+
+func (f PolicyBoundsFlags) PolicyBounds() types.PolicyBounds {
+	minProposalFee, proposalFeeCurrency := common.ZeroBig, ctypes.CurrencyID("")
+	if f.MinProposalFee != nil {
+		minProposalFee, proposalFeeCurrency = f.MinProposalFee.Big, f.MinProposalFee.CID
+	}
+
+	return types.NewPolicyBounds(
+		f.MinProposalReviewPeriod, f.MaxProposalReviewPeriod,
+		f.MinRegistrationPeriod, f.MaxRegistrationPeriod,
+		f.MinPreSnapshotPeriod, f.MaxPreSnapshotPeriod,
+		f.MinVotingPeriod, f.MaxVotingPeriod,
+		f.MinPostSnapshotPeriod, f.MaxPostSnapshotPeriod,
+		f.MinExecutionDelayPeriod, f.MaxExecutionDelayPeriod,
+		types.PercentRatio(f.MinTurnout), types.PercentRatio(f.MaxTurnout),
+		types.PercentRatio(f.MinQuorum), types.PercentRatio(f.MaxQuorum),
+		f.MinThreshold.Big, f.MaxThreshold.Big,
+		minProposalFee, proposalFeeCurrency,
+		types.PercentRatio(f.MinObjectionThreshold), types.PercentRatio(f.MaxObjectionThreshold),
+		f.MinExecutionGracePeriod, f.MaxExecutionGracePeriod,
+		f.MinGuardians, f.MaxGuardians, f.MinExecutors, f.MaxExecutors,
+	)
+}
+
+// PolicyPatchFlags are the flags of a partial policy update; a policy field is changed only
+// when its flag is given. The whitelist, deposit rule, guardian, reviewer and executor flags replace
+// the whole set they belong to.
+type PolicyPatchFlags struct {
+	VotingPowerToken      *ccmds.CurrencyIDFlag     `name:"voting-power-token" help:"voting power token"`
+	Threshold             *ccmds.BigFlag            `name:"threshold" help:"threshold to propose"`
+	Fee                   *ccmds.CurrencyAmountFlag `name:"fee" help:"fee to propose"`
+	Whitelist             []ccmds.AddressFlag       `name:"whitelist" help:"whitelist account"`
+	WhitelistActive       *bool                     `name:"whitelist-active" negatable:"" help:"activate whitelist; true if whitelist accounts are given"`
+	ProposalReviewPeriod  *uint64                   `name:"proposal-review-period" help:"proposal review period"`
+	RegistrationPeriod    *uint64                   `name:"registration-period" help:"registration period"`
+	PreSnapshotPeriod     *uint64                   `name:"pre-snapshot-period" help:"pre snapshot period"`
+	VotingPeriod          *uint64                   `name:"voting-period" help:"voting period"`
+	PostSnapshotPeriod    *uint64                   `name:"post-snapshot-period" help:"post snapshot period"`
+	ExecutionDelayPeriod  *uint64                   `name:"execution-delay-period" help:"execution delay period"`
+	Turnout               *uint                     `name:"turnout" help:"turnout"`
+	Quorum                *uint                     `name:"quorum" help:"quorum"`
+	DepositOnCompleted    *string                   `name:"deposit-on-completed" help:"deposit action on completed proposal; refund | keep | burn"`
+	DepositOnRejected     *string                   `name:"deposit-on-rejected" help:"deposit action on rejected proposal; refund | keep | burn"`
+	DepositOnCanceled     *string                   `name:"deposit-on-canceled" help:"deposit action on canceled proposal; refund | keep | burn"`
+	DepositOnWithdrawn    *string                   `name:"deposit-on-withdrawn" help:"deposit action on proposal canceled by proposer; refund | keep | burn"`
+	DepositPeriod         *uint64                   `name:"deposit-period" help:"period to collect proposal deposits; 0 disables crowdfunded deposits"`
+	DepositTarget         *ccmds.BigFlag            `name:"deposit-target" help:"deposit amount in proposal fee currency to move proposal into review"`
+	Guardian              []ccmds.AddressFlag       `name:"guardian" help:"guardian account which can veto completed security-critical proposals"`
+	GuardianThreshold     *uint                     `name:"guardian-threshold" help:"number of guardian vetoes to block execution; 0 without guardian accounts disables guardians"`
+	GuardianTransferLimit *ccmds.BigFlag            `name:"guardian-transfer-limit" help:"transfer amount from which guardians can veto the proposal"`
+	ObjectionThreshold    *uint                     `name:"objection-threshold" help:"percent of total supply disapproval votes must exceed to reject optimistic proposal; 0 disables optimistic proposals"`
+	ExecutionGracePeriod  *uint64                   `name:"execution-grace-period" help:"period to execute completed proposal after execution delay; 0 never expires"`
+	ExecutionRetries      *uint64                   `name:"execution-retries" help:"number of times failed execution can be retried; 0 disables retry"`
+	SponsorsRequired      *uint64                   `name:"sponsors-required" help:"number of whitelisted sponsors a proposal from non-whitelisted account needs; 0 disables sponsorship"`
+	Reviewer              []ccmds.AddressFlag       `name:"reviewer" help:"reviewer account which can reject proposals during review period"`
+	ClearReviewers        bool                      `name:"clear-reviewers" help:"remove all reviewers"`
+	VoterAllowlist        *bool                     `name:"voter-allowlist" negatable:"" help:"only accounts in voter allowlist can register and vote"`
+	MinLeadTime           *uint64                   `name:"min-lead-time" help:"min time from proposal submission to its start time"`
+	MaxLeadTime           *uint64                   `name:"max-lead-time" help:"max time from proposal submission to its start time; 0 disables max"`
+	MaxActiveProposals    *uint64                   `name:"max-active-proposals" help:"max number of proposals of the dao which have not ended; 0 disables max"`
+	MaxProposerProposals  *uint64                   `name:"max-proposer-proposals" help:"max number of proposals of a proposer which have not ended; 0 disables max"`
+	Executor              []ccmds.AddressFlag       `name:"executor" help:"executor account which alone can pre-snap, post-snap and execute proposals; governance proposal only"`
+	ClearExecutors        bool                      `name:"clear-executors" help:"remove all executors; governance proposal only"`
+}
+
+func encodeAddressFlags(enc encoder.Encoder, flags []ccmds.AddressFlag, name string) ([]base.Address, error) {
+	accounts := make([]base.Address, len(flags))
+
+	for i := range flags {
+		a, err := flags[i].Encode(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s account format, %q", name, flags[i].String())
+		}
+		accounts[i] = a
+	}
+
+	return accounts, nil
+}
+
+func (f PolicyPatchFlags) PolicyPatch(enc encoder.Encoder) (types.PolicyPatch, error) {
+	var token *ctypes.CurrencyID
+	if f.VotingPowerToken != nil {
+		token = &f.VotingPowerToken.CID
+	}
+
+	var threshold *common.Big
+	if f.Threshold != nil {
+		threshold = &f.Threshold.Big
+	}
+
+	var fee *ctypes.Amount
+	if f.Fee != nil {
+		am := ctypes.NewAmount(f.Fee.Big, f.Fee.CID)
+		fee = &am
+	}
+
+	var whitelist *types.Whitelist
+	if 0 < len(f.Whitelist) || f.WhitelistActive != nil {
+		accounts, err := encodeAddressFlags(enc, f.Whitelist, "whitelist")
+		if err != nil {
+			return types.PolicyPatch{}, err
+		}
+
+		active := 0 < len(accounts)
+		if f.WhitelistActive != nil {
+			active = *f.WhitelistActive
+		}
+
+		wl := types.NewWhitelist(active, accounts)
+		whitelist = &wl
+	}
+
+	var depositRule *types.DepositRule
+	if names := []*string{
+		f.DepositOnCompleted, f.DepositOnRejected, f.DepositOnCanceled, f.DepositOnWithdrawn,
+	}; names[0] != nil || names[1] != nil || names[2] != nil || names[3] != nil {
+		actions := make([]types.DepositAction, len(names))
+
+		for i, name := range names {
+			if name == nil {
+				return types.PolicyPatch{}, errors.Errorf("deposit rule needs all of the deposit-on flags")
+			}
+
+			a, err := types.DepositActionFromString(*name)
+			if err != nil {
+				return types.PolicyPatch{}, errors.Wrap(err, "invalid deposit rule")
+			}
+			actions[i] = a
+		}
+
+		dr := types.NewDepositRule(actions[0], actions[1], actions[2], actions[3])
+		depositRule = &dr
+	}
+
+	var depositTarget *common.Big
+	if f.DepositTarget != nil {
+		depositTarget = &f.DepositTarget.Big
+	}
+
+	var guardians *types.Guardians
+	if 0 < len(f.Guardian) || f.GuardianThreshold != nil || f.GuardianTransferLimit != nil {
+		accounts, err := encodeAddressFlags(enc, f.Guardian, "guardian")
+		if err != nil {
+			return types.PolicyPatch{}, err
+		}
+
+		var threshold uint
+		if f.GuardianThreshold != nil {
+			threshold = *f.GuardianThreshold
+		}
+
+		transferLimit := common.ZeroBig
+		if f.GuardianTransferLimit != nil {
+			transferLimit = f.GuardianTransferLimit.Big
+		}
+
+		gd := types.NewGuardians(accounts, threshold, transferLimit)
+		guardians = &gd
+	}
+
+	var reviewers *types.Reviewers
+	if 0 < len(f.Reviewer) || f.ClearReviewers {
+		if 0 < len(f.Reviewer) && f.ClearReviewers {
+			return types.PolicyPatch{}, errors.Errorf("reviewer accounts with clear-reviewers")
+		}
+
+		accounts, err := encodeAddressFlags(enc, f.Reviewer, "reviewer")
+		if err != nil {
+			return types.PolicyPatch{}, err
+		}
+
+		rv := types.NewReviewers(accounts)
+		reviewers = &rv
+	}
+
+	var executors *types.Executors
+	if 0 < len(f.Executor) || f.ClearExecutors {
+		if 0 < len(f.Executor) && f.ClearExecutors {
+			return types.PolicyPatch{}, errors.Errorf("executor accounts with clear-executors")
+		}
+
+		accounts, err := encodeAddressFlags(enc, f.Executor, "executor")
+		if err != nil {
+			return types.PolicyPatch{}, err
+		}
+
+		ex := types.NewExecutors(accounts)
+		executors = &ex
+	}
+
+	patch := types.NewPolicyPatch(
+		token,
+		threshold,
+		fee,
+		whitelist,
+		f.ProposalReviewPeriod,
+		f.RegistrationPeriod,
+		f.PreSnapshotPeriod,
+		f.VotingPeriod,
+		f.PostSnapshotPeriod,
+		f.ExecutionDelayPeriod,
+		percentRatioFlag(f.Turnout),
+		percentRatioFlag(f.Quorum),
+		depositRule,
+		f.DepositPeriod,
+		depositTarget,
+		guardians,
+		percentRatioFlag(f.ObjectionThreshold),
+		f.ExecutionGracePeriod,
+		f.ExecutionRetries,
+		f.SponsorsRequired,
+		reviewers,
+		f.VoterAllowlist,
+		f.MinLeadTime,
+		f.MaxLeadTime,
+		f.MaxActiveProposals,
+		f.MaxProposerProposals,
+		executors,
+	)
+	if err := patch.IsValid(nil); err != nil {
+		return types.PolicyPatch{}, err
+	}
+
+	return patch, nil
+}
+
+func percentRatioFlag(v *uint) *types.PercentRatio {
+	if v == nil {
+		return nil
+	}
+
+	r := types.PercentRatio(*v)
+
+	return &r
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/imfact-labs/dao-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/pkg/errors"
 )
 
@@ -19,24 +20,20 @@ type TransferCallDataCommand struct {
 }
 
 type GovernanceCallDataCommand struct {
-	VotingPowerToken     ccmds.CurrencyIDFlag     `name:"voting-power-token" help:"voting power token"`
-	Threshold            ccmds.BigFlag            `name:"threshold" help:"threshold to propose"`
-	Fee                  ccmds.CurrencyAmountFlag `name:"fee" help:"fee to propose"`
-	ProposalReviewPeriod uint64                   `name:"proposal-review-period" help:"proposal review period"`
-	RegistrationPeriod   uint64                   `name:"registration-period" help:"registration period"`
-	PreSnapshotPeriod    uint64                   `name:"pre-snapshot-period" help:"pre snapshot period"`
-	VotingPeriod         uint64                   `name:"voting-period" help:"voting period"`
-	PostSnapshotPeriod   uint64                   `name:"post-snapshot-period" help:"post snapshot period"`
-	ExecutionDelayPeriod uint64                   `name:"execution-delay-period" help:"execution delay period"`
-	Turnout              uint                     `name:"turnout" help:"turnout"`
-	Quorum               uint                     `name:"quorum" help:"quorum"`
-	Whitelist            ccmds.AddressFlag        `name:"whitelist" help:"whitelist account"`
+	PolicyPatchFlags
+}
+
+type WhitelistCallDataCommand struct {
+	WhitelistAdd    []ccmds.AddressFlag `name:"whitelist-add" help:"account to be added to whitelist"`
+	WhitelistRemove []ccmds.AddressFlag `name:"whitelist-remove" help:"account to be removed from whitelist"`
 }
 
 type CryptoProposalCommand struct {
-	CalldataOption string `name:"calldata-option" help:"calldata option; transfer | governance"`
+	CalldataOption string `name:"calldata-option" help:"calldata option; transfer | governance | whitelist"`
+	Optimistic     bool   `name:"optimistic" help:"complete proposal unless disapproval votes exceed objection threshold"`
 	TransferCallDataCommand
 	GovernanceCallDataCommand
+	WhitelistCallDataCommand
 }
 
 type BizProposalCommand struct {
@@ -51,8 +48,9 @@ type ProposeCommand struct {
 	Sender     ccmds.AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract   ccmds.AddressFlag `arg:"" name:"contract" help:"contract address of credential" required:"true"`
 	Option     types.DAOOption   `arg:"" name:"option" help:"propose option; crypto | biz" required:"true"`
-	ProposalID string            `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
-	StartTime  uint64            `arg:"" name:"start-time" help:"start time to proposal lifecycle" required:"true"`
+	ProposalID string            `arg:"" name:"proposal-id" help:"proposal id; empty to take the next sequence number" required:"true"`
+	StartTime  uint64            `arg:"" name:"start-time" help:"start time to proposal lifecycle; block height if dao periods are in heights" required:"true"`
+	Dependency []string          `name:"dependency" help:"prerequisite proposal id; repeatable"`
 	CryptoProposalCommand
 	BizProposalCommand
 	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
@@ -97,101 +95,132 @@ func (cmd *ProposeCommand) parseFlags() error {
 	}
 	cmd.contract = contract
 
-	if cmd.Option == types.ProposalCrypto {
-		if cmd.CalldataOption == types.CalldataTransfer {
-			from, err := cmd.From.Encode(cmd.Encoders.JSON())
+	proposal, err := newProposal(
+		cmd.Encoders.JSON(), sender, cmd.Option, cmd.StartTime, cmd.Dependency,
+		cmd.CryptoProposalCommand, cmd.BizProposalCommand,
+	)
+	if err != nil {
+		return err
+	}
+	cmd.proposal = proposal
+
+	return nil
+}
+
+func (cmd *ProposeCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create propose operation")
+
+	fact := dao.NewProposeFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		cmd.proposal,
+		cmd.Currency.CID,
+	)
+
+	op := dao.NewPropose(fact)
+	err := op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
+
+// newProposal builds the proposal of the option from the proposal flags.
+func newProposal(
+	enc encoder.Encoder,
+	proposer base.Address,
+	option types.DAOOption,
+	startTime uint64,
+	dependencies []string,
+	crypto CryptoProposalCommand,
+	biz BizProposalCommand,
+) (types.Proposal, error) {
+	if option == types.ProposalCrypto {
+		if crypto.CalldataOption == types.CalldataTransfer {
+			from, err := crypto.From.Encode(enc)
 			if err != nil {
-				return errors.Wrapf(err, "invalid from address format, %q", cmd.From.String())
+				return nil, errors.Wrapf(err, "invalid from address format, %q", crypto.From.String())
 			}
 
-			to, err := cmd.To.Encode(cmd.Encoders.JSON())
+			to, err := crypto.To.Encode(enc)
 			if err != nil {
-				return errors.Wrapf(err, "invalid to address format, %q", cmd.To.String())
+				return nil, errors.Wrapf(err, "invalid to address format, %q", crypto.To.String())
 			}
 
-			amount := ctypes.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+			amount := ctypes.NewAmount(crypto.Amount.Big, crypto.Amount.CID)
 
 			callData := types.NewTransferCallData(from, to, amount)
 			if err := callData.IsValid(nil); err != nil {
-				return err
+				return nil, err
 			}
 
-			proposal := types.NewCryptoProposal(sender, cmd.StartTime, callData)
+			proposal := types.NewCryptoProposal(proposer, startTime, callData, crypto.Optimistic, dependencies)
 			if err := proposal.IsValid(nil); err != nil {
-				return err
+				return nil, err
+			}
+
+			return proposal, nil
+		} else if crypto.CalldataOption == types.CalldataGovernance {
+			patch, err := crypto.PolicyPatchFlags.PolicyPatch(enc)
+			if err != nil {
+				return nil, err
 			}
-			cmd.proposal = proposal
-		} else if cmd.CalldataOption == types.CalldataGovernance {
-			whitelist := types.NewWhitelist(false, []base.Address{})
 
-			if 0 < len(cmd.Whitelist.String()) {
-				a, err := cmd.Whitelist.Encode(cmd.Encoders.JSON())
+			calldata := types.NewGovernanceCallData(patch)
+			if err := calldata.IsValid(nil); err != nil {
+				return nil, err
+			}
+
+			proposal := types.NewCryptoProposal(proposer, startTime, calldata, crypto.Optimistic, dependencies)
+			if err := proposal.IsValid(nil); err != nil {
+				return nil, err
+			}
+
+			return proposal, nil
+		} else if crypto.CalldataOption == types.CalldataWhitelist {
+			add := make([]base.Address, len(crypto.WhitelistAdd))
+			for i := range crypto.WhitelistAdd {
+				a, err := crypto.WhitelistAdd[i].Encode(enc)
 				if err != nil {
-					return errors.Wrapf(err, "invalid whitelist account format, %q", cmd.Whitelist.String())
+					return nil, errors.Wrapf(err, "invalid whitelist account format, %q", crypto.WhitelistAdd[i].String())
 				}
-				whitelist = types.NewWhitelist(true, []base.Address{a})
+				add[i] = a
 			}
 
-			fee := ctypes.NewAmount(cmd.Fee.Big, cmd.Fee.CID)
-
-			policy := types.NewPolicy(
-				cmd.VotingPowerToken.CID, cmd.Threshold.Big,
-				fee, whitelist,
-				cmd.ProposalReviewPeriod,
-				cmd.RegistrationPeriod,
-				cmd.PreSnapshotPeriod,
-				cmd.VotingPeriod,
-				cmd.PostSnapshotPeriod,
-				cmd.ExecutionDelayPeriod,
-				types.PercentRatio(cmd.Turnout), types.PercentRatio(cmd.Quorum),
-			)
-			if err := policy.IsValid(nil); err != nil {
-				return err
+			remove := make([]base.Address, len(crypto.WhitelistRemove))
+			for i := range crypto.WhitelistRemove {
+				a, err := crypto.WhitelistRemove[i].Encode(enc)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid whitelist account format, %q", crypto.WhitelistRemove[i].String())
+				}
+				remove[i] = a
 			}
 
-			calldata := types.NewGovernanceCallData(policy)
+			calldata := types.NewWhitelistCallData(add, remove)
 			if err := calldata.IsValid(nil); err != nil {
-				return err
+				return nil, err
 			}
 
-			proposal := types.NewCryptoProposal(sender, cmd.StartTime, calldata)
+			proposal := types.NewCryptoProposal(proposer, startTime, calldata, crypto.Optimistic, dependencies)
 			if err := proposal.IsValid(nil); err != nil {
-				return err
+				return nil, err
 			}
-			cmd.proposal = proposal
+
+			return proposal, nil
 		} else {
-			return errors.Errorf("invalid calldata option, %s", cmd.CalldataOption)
+			return nil, errors.Errorf("invalid calldata option, %s", crypto.CalldataOption)
 		}
-	} else if cmd.Option == types.ProposalBiz {
-		proposal := types.NewBizProposal(sender, cmd.StartTime, cmd.URL, cmd.Hash, cmd.Options)
+	} else if option == types.ProposalBiz {
+		proposal := types.NewBizProposal(proposer, startTime, biz.URL, biz.Hash, biz.Options, dependencies)
 		if err := proposal.IsValid(nil); err != nil {
-			return err
+			return nil, err
 		}
-		cmd.proposal = proposal
-	} else {
-		return errors.Errorf("invalid proposal option, %s", cmd.Option)
-	}
-
-	return nil
-}
-
-func (cmd *ProposeCommand) createOperation() (base.Operation, error) { // nolint:dupl}
-	e := util.StringError("failed to create propose operation")
 
-	fact := dao.NewProposeFact(
-		[]byte(cmd.Token),
-		cmd.sender,
-		cmd.contract,
-		cmd.ProposalID,
-		cmd.proposal,
-		cmd.Currency.CID,
-	)
-
-	op := dao.NewPropose(fact)
-	err := op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
-	if err != nil {
-		return nil, e.Wrap(err)
+		return proposal, nil
+	} else {
+		return nil, errors.Errorf("invalid proposal option, %s", option)
 	}
-
-	return op, nil
 }
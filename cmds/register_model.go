@@ -15,6 +15,19 @@ import (
 type RegisterModelCommand struct {
 	BaseCommand
 	ccmds.OperationFlags
+	DepositRuleFlags
+	CrowdfundFlags
+	GuardianFlags
+	OptimisticFlags
+	ExecutionFlags
+	SponsorFlags
+	ReviewerFlags
+	ExecutorFlags
+	VoterAllowlistFlags
+	PeriodUnitFlags
+	LeadTimeFlags
+	ActiveProposalFlags
+	PolicyBoundsFlags
 	Sender               ccmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract             ccmds.AddressFlag        `arg:"" name:"contract" help:"contract address of credential" required:"true"`
 	Option               string                   `arg:"" name:"dao-option" help:"dao option" required:"true"`
@@ -35,6 +48,11 @@ type RegisterModelCommand struct {
 	contract             base.Address
 	whitelist            types.Whitelist
 	fee                  ctypes.Amount
+	depositRule          types.DepositRule
+	guardians            types.Guardians
+	reviewers            types.Reviewers
+	executors            types.Executors
+	periodUnit           types.PeriodUnit
 }
 
 func (cmd *RegisterModelCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -85,6 +103,36 @@ func (cmd *RegisterModelCommand) parseFlags() error {
 
 	cmd.fee = ctypes.NewAmount(cmd.Fee.Big, cmd.Fee.CID)
 
+	depositRule, err := cmd.DepositRuleFlags.DepositRule()
+	if err != nil {
+		return err
+	}
+	cmd.depositRule = depositRule
+
+	guardians, err := cmd.GuardianFlags.Guardians(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+	cmd.guardians = guardians
+
+	reviewers, err := cmd.ReviewerFlags.Reviewers(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+	cmd.reviewers = reviewers
+
+	executors, err := cmd.ExecutorFlags.Executors(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+	cmd.executors = executors
+
+	periodUnit, err := types.PeriodUnitFromString(cmd.PeriodUnitFlags.PeriodUnit)
+	if err != nil {
+		return err
+	}
+	cmd.periodUnit = periodUnit
+
 	return nil
 }
 
@@ -108,6 +156,23 @@ func (cmd *RegisterModelCommand) createOperation() (base.Operation, error) { //
 		cmd.ExecutionDelayPeriod,
 		types.PercentRatio(cmd.Turnout),
 		types.PercentRatio(cmd.Quorum),
+		cmd.depositRule,
+		cmd.DepositPeriod,
+		cmd.DepositTarget.Big,
+		cmd.guardians,
+		types.PercentRatio(cmd.ObjectionThreshold),
+		cmd.ExecutionGracePeriod,
+		cmd.ExecutionRetries,
+		cmd.SponsorsRequired,
+		cmd.reviewers,
+		cmd.VoterAllowlist,
+		cmd.periodUnit,
+		cmd.MinLeadTime,
+		cmd.MaxLeadTime,
+		cmd.MaxActiveProposals,
+		cmd.MaxProposerProposals,
+		cmd.executors,
+		cmd.PolicyBounds(),
 		cmd.Currency.CID,
 	)
 
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	ccmds "github.com/imfact-labs/currency-model/app/cmds"
-	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/operation/dao"
 	"github.com/imfact-labs/dao-model/types"
 	"github.com/imfact-labs/mitum2/base"
@@ -15,26 +14,14 @@ import (
 type UpdateModelConfigCommand struct {
 	BaseCommand
 	ccmds.OperationFlags
-	Sender               ccmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract             ccmds.AddressFlag        `arg:"" name:"contract" help:"contract address of credential" required:"true"`
-	Option               string                   `arg:"" name:"dao-option" help:"dao option" required:"true"`
-	VotingPowerToken     ccmds.CurrencyIDFlag     `arg:"" name:"voting-power-token" help:"voting power token" required:"true"`
-	Threshold            ccmds.BigFlag            `arg:"" name:"threshold" help:"threshold to propose" required:"true"`
-	Fee                  ccmds.CurrencyAmountFlag `arg:"" name:"fee" help:"fee to propose" required:"true"`
-	ProposalReviewPeriod uint64                   `arg:"" name:"proposal-review-period" help:"proposal review period" required:"true"`
-	RegistrationPeriod   uint64                   `arg:"" name:"registration-period" help:"registration period" required:"true"`
-	PreSnapshotPeriod    uint64                   `arg:"" name:"pre-snapshot-period" help:"pre snapshot period" required:"true"`
-	VotingPeriod         uint64                   `arg:"" name:"voting-period" help:"voting period" required:"true"`
-	PostSnapshotPeriod   uint64                   `arg:"" name:"post-snapshot-period" help:"post snapshot period" required:"true"`
-	ExecutionDelayPeriod uint64                   `arg:"" name:"execution-delay-period" help:"execution delay period" required:"true"`
-	Turnout              uint                     `arg:"" name:"turnout" help:"turnout" required:"true"`
-	Quorum               uint                     `arg:"" name:"quorum" help:"quorum" required:"true"`
-	Whitelist            ccmds.AddressFlag        `name:"whitelist" help:"whitelist account"`
-	Currency             ccmds.CurrencyIDFlag     `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	sender               base.Address
-	contract             base.Address
-	whitelist            types.Whitelist
-	fee                  ctypes.Amount
+	PolicyPatchFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Option   string               `name:"dao-option" help:"dao option; empty to keep current option"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+	patch    types.PolicyPatch
 }
 
 func (cmd *UpdateModelConfigCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -73,17 +60,11 @@ func (cmd *UpdateModelConfigCommand) parseFlags() error {
 	}
 	cmd.contract = contract
 
-	if 0 < len(cmd.Whitelist.String()) {
-		whitelist, err := cmd.Whitelist.Encode(cmd.Encoders.JSON())
-		if err != nil {
-			return errors.Wrapf(err, "invalid whitelist account format, %q", cmd.Whitelist.String())
-		}
-		cmd.whitelist = types.NewWhitelist(true, []base.Address{whitelist})
-	} else {
-		cmd.whitelist = types.NewWhitelist(false, []base.Address{})
+	patch, err := cmd.PolicyPatchFlags.PolicyPatch(cmd.Encoders.JSON())
+	if err != nil {
+		return err
 	}
-
-	cmd.fee = ctypes.NewAmount(cmd.Fee.Big, cmd.Fee.CID)
+	cmd.patch = patch
 
 	return nil
 }
@@ -96,18 +77,7 @@ func (cmd *UpdateModelConfigCommand) createOperation() (base.Operation, error) {
 		cmd.sender,
 		cmd.contract,
 		types.DAOOption(cmd.Option),
-		cmd.VotingPowerToken.CID,
-		cmd.Threshold.Big,
-		cmd.fee,
-		cmd.whitelist,
-		cmd.ProposalReviewPeriod,
-		cmd.RegistrationPeriod,
-		cmd.PreSnapshotPeriod,
-		cmd.VotingPeriod,
-		cmd.PostSnapshotPeriod,
-		cmd.ExecutionDelayPeriod,
-		types.PercentRatio(cmd.Turnout),
-		types.PercentRatio(cmd.Quorum),
+		cmd.patch,
 		cmd.Currency.CID,
 	)
 
@@ -42,6 +42,48 @@ func PrepareDAO(bs *cdigest.BlockSession, st mitumbase.State) (string, []mongo.W
 		}
 
 		return DefaultColNameDAOVotingPowerBox, j, nil
+	case state.IsStateStatusHistoryKey(st.Key()):
+		j, err := handleDAOStatusHistoryState(bs, st)
+		if err != nil {
+			return "", nil, nil
+		}
+
+		return DefaultColNameDAOStatusHistory, j, nil
+	case state.IsStateTallyKey(st.Key()):
+		j, err := handleDAOTallyState(bs, st)
+		if err != nil {
+			return "", nil, nil
+		}
+
+		return DefaultColNameDAOTally, j, nil
+	case state.IsStateSponsorsKey(st.Key()):
+		j, err := handleDAOSponsorsState(bs, st)
+		if err != nil {
+			return "", nil, nil
+		}
+
+		return DefaultColNameDAOSponsors, j, nil
+	case state.IsStateWhitelistMemberKey(st.Key()):
+		j, err := handleDAOWhitelistMemberState(bs, st)
+		if err != nil {
+			return "", nil, nil
+		}
+
+		return DefaultColNameDAOWhitelist, j, nil
+	case state.IsStateVoterAllowlistMemberKey(st.Key()):
+		j, err := handleDAOVoterAllowlistMemberState(bs, st)
+		if err != nil {
+			return "", nil, nil
+		}
+
+		return DefaultColNameDAOVoterAllowlist, j, nil
+	case state.IsStateRolesKey(st.Key()):
+		j, err := handleDAORolesState(bs, st)
+		if err != nil {
+			return "", nil, nil
+		}
+
+		return DefaultColNameDAORoles, j, nil
 	}
 
 	return "", nil, nil
@@ -96,3 +138,63 @@ func handleDAOVotingPowerBoxState(bs *cdigest.BlockSession, st mitumbase.State)
 		}, nil
 	}
 }
+
+func handleDAOStatusHistoryState(bs *cdigest.BlockSession, st mitumbase.State) ([]mongo.WriteModel, error) {
+	if statusHistoryDoc, err := NewDAOStatusHistoryDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(statusHistoryDoc),
+		}, nil
+	}
+}
+
+func handleDAOTallyState(bs *cdigest.BlockSession, st mitumbase.State) ([]mongo.WriteModel, error) {
+	if tallyDoc, err := NewDAOTallyDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(tallyDoc),
+		}, nil
+	}
+}
+
+func handleDAOSponsorsState(bs *cdigest.BlockSession, st mitumbase.State) ([]mongo.WriteModel, error) {
+	if sponsorsDoc, err := NewDAOSponsorsDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(sponsorsDoc),
+		}, nil
+	}
+}
+
+func handleDAOWhitelistMemberState(bs *cdigest.BlockSession, st mitumbase.State) ([]mongo.WriteModel, error) {
+	if memberDoc, err := NewDAOWhitelistMemberDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(memberDoc),
+		}, nil
+	}
+}
+
+func handleDAOVoterAllowlistMemberState(bs *cdigest.BlockSession, st mitumbase.State) ([]mongo.WriteModel, error) {
+	if memberDoc, err := NewDAOVoterAllowlistMemberDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(memberDoc),
+		}, nil
+	}
+}
+
+func handleDAORolesState(bs *cdigest.BlockSession, st mitumbase.State) ([]mongo.WriteModel, error) {
+	if rolesDoc, err := NewDAORolesDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(rolesDoc),
+		}, nil
+	}
+}
@@ -1,12 +1,15 @@
 package digest
 
 import (
+	"context"
+
 	cdigest "github.com/imfact-labs/currency-model/digest"
 	"github.com/imfact-labs/currency-model/digest/util"
 	"github.com/imfact-labs/dao-model/state"
 	"github.com/imfact-labs/dao-model/types"
 	mitumbase "github.com/imfact-labs/mitum2/base"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
@@ -17,6 +20,12 @@ var (
 	DefaultColNameDAODelegators     = "digest_dao_dac"
 	DefaultColNameDAOVoters         = "digest_dao_vac"
 	DefaultColNameDAOVotingPowerBox = "digest_dao_vpb"
+	DefaultColNameDAOStatusHistory  = "digest_dao_sh"
+	DefaultColNameDAOTally          = "digest_dao_ta"
+	DefaultColNameDAOSponsors       = "digest_dao_sp"
+	DefaultColNameDAOWhitelist      = "digest_dao_wl"
+	DefaultColNameDAOVoterAllowlist = "digest_dao_vl"
+	DefaultColNameDAORoles          = "digest_dao_ro"
 )
 
 func DAOService(st *cdigest.Database, contract string) (*types.Design, error) {
@@ -51,6 +60,186 @@ func DAOService(st *cdigest.Database, contract string) (*types.Design, error) {
 	return &design, nil
 }
 
+// DAOPolicy returns the dao design state value with the given policy version.
+func DAOPolicy(st *cdigest.Database, contract string, version uint64) (*state.DesignStateValue, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("policy_version", version)
+
+	var design state.DesignStateValue
+	var sta mitumbase.State
+	var err error
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.MongoClient().GetByFilter(
+		DefaultColNameDAO,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			design, err = state.StateDesignStateValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &design, nil
+}
+
+// DAOProposals returns the latest states of the proposals proposed without an id
+// in the order of their sequence numbers, starting after the offset.
+func DAOProposals(
+	st *cdigest.Database, contract string, offset uint64, limit int64,
+) ([]state.ProposalStateValue, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "contract", Value: contract},
+			{Key: "proposal_sequence", Value: bson.D{{Key: "$gt", Value: offset}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "height", Value: -1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$proposal_id"},
+			{Key: "doc", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$doc"}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "proposal_sequence", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	var proposals []state.ProposalStateValue
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err := st.MongoClient().Aggregate(
+		context.Background(),
+		DefaultColNameDAOProposal,
+		pipeline,
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := cdigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			proposal, err := state.StateProposalValue(sta)
+			if err != nil {
+				return false, err
+			}
+			proposals = append(proposals, proposal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return proposals, nil
+}
+
+// DAOWhitelist returns the latest states of the active managed whitelist
+// members in the order of their addresses, starting after the offset.
+func DAOWhitelist(
+	st *cdigest.Database, contract, offset string, limit int64,
+) ([]state.WhitelistMemberStateValue, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "contract", Value: contract},
+			{Key: "account", Value: bson.D{{Key: "$gt", Value: offset}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "height", Value: -1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$account"},
+			{Key: "doc", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$doc"}}}},
+		{{Key: "$match", Value: bson.D{{Key: "active", Value: true}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "account", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	var members []state.WhitelistMemberStateValue
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err := st.MongoClient().Aggregate(
+		context.Background(),
+		DefaultColNameDAOWhitelist,
+		pipeline,
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := cdigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			member, err := state.StateWhitelistMemberValue(sta)
+			if err != nil {
+				return false, err
+			}
+			members = append(members, member)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// DAOVoterAllowlist returns the latest states of the active voter allowlist
+// members in the order of their addresses, starting after the offset.
+func DAOVoterAllowlist(
+	st *cdigest.Database, contract, offset string, limit int64,
+) ([]state.VoterAllowlistMemberStateValue, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "contract", Value: contract},
+			{Key: "account", Value: bson.D{{Key: "$gt", Value: offset}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "height", Value: -1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$account"},
+			{Key: "doc", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$doc"}}}},
+		{{Key: "$match", Value: bson.D{{Key: "active", Value: true}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "account", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	var members []state.VoterAllowlistMemberStateValue
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err := st.MongoClient().Aggregate(
+		context.Background(),
+		DefaultColNameDAOVoterAllowlist,
+		pipeline,
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := cdigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			member, err := state.StateVoterAllowlistMemberValue(sta)
+			if err != nil {
+				return false, err
+			}
+			members = append(members, member)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
 func DAODelegatorInfo(st *cdigest.Database, contract, proposalID, delegator string) (*types.DelegatorInfo, error) {
 	var (
 		delegators    []types.DelegatorInfo
@@ -192,3 +381,131 @@ func DAOVotingPowerBox(st *cdigest.Database, contract, proposalID string) (*type
 
 	return &votingPowerBox, nil
 }
+
+func DAOStatusHistory(st *cdigest.Database, contract, proposalID string) ([]types.StatusTransition, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("proposal_id", proposalID)
+
+	var transitions []types.StatusTransition
+	var sta mitumbase.State
+	var err error
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.MongoClient().GetByFilter(
+		DefaultColNameDAOStatusHistory,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+			transitions, err = state.StateStatusHistoryValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+func DAOTally(st *cdigest.Database, contract, proposalID string) (*types.Tally, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("proposal_id", proposalID)
+
+	var tally types.Tally
+	var sta mitumbase.State
+	var err error
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.MongoClient().GetByFilter(
+		DefaultColNameDAOTally,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+			tally, err = state.StateTallyValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &tally, nil
+}
+
+func DAOSponsors(st *cdigest.Database, contract, proposalID string) ([]types.SponsorInfo, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("proposal_id", proposalID)
+
+	var sponsors []types.SponsorInfo
+	var sta mitumbase.State
+	var err error
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.MongoClient().GetByFilter(
+		DefaultColNameDAOSponsors,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+			sponsors, err = state.StateSponsorsValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return sponsors, nil
+}
+
+func DAORoles(st *cdigest.Database, contract, account string) (*state.RolesStateValue, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("account", account)
+
+	var roles state.RolesStateValue
+	var sta mitumbase.State
+	var err error
+	if st.MongoClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.MongoClient().GetByFilter(
+		DefaultColNameDAORoles,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+			roles, err = state.StateRolesValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &roles, nil
+}
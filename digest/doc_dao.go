@@ -13,11 +13,11 @@ import (
 type DAODesignDoc struct {
 	mongodbst.BaseDoc
 	st base.State
-	de types.Design
+	de statedao.DesignStateValue
 }
 
 func NewDAODesignDoc(st base.State, enc encoder.Encoder) (DAODesignDoc, error) {
-	de, err := statedao.StateDesignValue(st)
+	de, err := statedao.StateDesignStateValue(st)
 	if err != nil {
 		return DAODesignDoc{}, err
 	}
@@ -42,6 +42,7 @@ func (doc DAODesignDoc) MarshalBSON() ([]byte, error) {
 	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 3)
 	m["contract"] = parsedKey[1]
 	m["height"] = doc.st.Height()
+	m["policy_version"] = doc.de.PolicyVersion()
 	//m["design"] = doc.de
 
 	return bsonenc.Marshal(m)
@@ -53,6 +54,7 @@ type DAOProposalDoc struct {
 	pr  types.Proposal
 	ps  types.ProposalStatus
 	prs string
+	seq uint64
 }
 
 func NewDAOProposalDoc(st base.State, enc encoder.Encoder) (DAOProposalDoc, error) {
@@ -71,6 +73,7 @@ func NewDAOProposalDoc(st base.State, enc encoder.Encoder) (DAOProposalDoc, erro
 		pr:      pv.Proposal(),
 		ps:      pv.Status(),
 		prs:     pv.Reason(),
+		seq:     pv.Sequence(),
 	}, nil
 }
 
@@ -87,6 +90,7 @@ func (doc DAOProposalDoc) MarshalBSON() ([]byte, error) {
 	m["proposal"] = doc.pr
 	m["proposal_status"] = doc.ps
 	m["proposal_status_reason"] = doc.prs
+	m["proposal_sequence"] = doc.seq
 
 	return bsonenc.Marshal(m)
 }
@@ -204,3 +208,230 @@ func (doc DAOVotingPowerBoxDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type DAOStatusHistoryDoc struct {
+	mongodbst.BaseDoc
+	st          base.State
+	transitions []types.StatusTransition
+}
+
+func NewDAOStatusHistoryDoc(st base.State, enc encoder.Encoder) (DAOStatusHistoryDoc, error) {
+	transitions, err := statedao.StateStatusHistoryValue(st)
+	if err != nil {
+		return DAOStatusHistoryDoc{}, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOStatusHistoryDoc{}, err
+	}
+
+	return DAOStatusHistoryDoc{
+		BaseDoc:     b,
+		st:          st,
+		transitions: transitions,
+	}, nil
+}
+
+func (doc DAOStatusHistoryDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["proposal_id"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+	m["status_transitions"] = doc.transitions
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOTallyDoc struct {
+	mongodbst.BaseDoc
+	st    base.State
+	tally types.Tally
+}
+
+func NewDAOTallyDoc(st base.State, enc encoder.Encoder) (DAOTallyDoc, error) {
+	tally, err := statedao.StateTallyValue(st)
+	if err != nil {
+		return DAOTallyDoc{}, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOTallyDoc{}, err
+	}
+
+	return DAOTallyDoc{
+		BaseDoc: b,
+		st:      st,
+		tally:   tally,
+	}, nil
+}
+
+func (doc DAOTallyDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["proposal_id"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+	m["tally"] = doc.tally
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOSponsorsDoc struct {
+	mongodbst.BaseDoc
+	st       base.State
+	sponsors []types.SponsorInfo
+}
+
+func NewDAOSponsorsDoc(st base.State, enc encoder.Encoder) (DAOSponsorsDoc, error) {
+	sponsors, err := statedao.StateSponsorsValue(st)
+	if err != nil {
+		return DAOSponsorsDoc{}, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOSponsorsDoc{}, err
+	}
+
+	return DAOSponsorsDoc{
+		BaseDoc:  b,
+		st:       st,
+		sponsors: sponsors,
+	}, nil
+}
+
+func (doc DAOSponsorsDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["proposal_id"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+	m["sponsors"] = doc.sponsors
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOWhitelistMemberDoc struct {
+	mongodbst.BaseDoc
+	st     base.State
+	member statedao.WhitelistMemberStateValue
+}
+
+func NewDAOWhitelistMemberDoc(st base.State, enc encoder.Encoder) (DAOWhitelistMemberDoc, error) {
+	member, err := statedao.StateWhitelistMemberValue(st)
+	if err != nil {
+		return DAOWhitelistMemberDoc{}, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOWhitelistMemberDoc{}, err
+	}
+
+	return DAOWhitelistMemberDoc{
+		BaseDoc: b,
+		st:      st,
+		member:  member,
+	}, nil
+}
+
+func (doc DAOWhitelistMemberDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["account"] = doc.member.Account().String()
+	m["active"] = doc.member.Active()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOVoterAllowlistMemberDoc struct {
+	mongodbst.BaseDoc
+	st     base.State
+	member statedao.VoterAllowlistMemberStateValue
+}
+
+func NewDAOVoterAllowlistMemberDoc(st base.State, enc encoder.Encoder) (DAOVoterAllowlistMemberDoc, error) {
+	member, err := statedao.StateVoterAllowlistMemberValue(st)
+	if err != nil {
+		return DAOVoterAllowlistMemberDoc{}, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOVoterAllowlistMemberDoc{}, err
+	}
+
+	return DAOVoterAllowlistMemberDoc{
+		BaseDoc: b,
+		st:      st,
+		member:  member,
+	}, nil
+}
+
+func (doc DAOVoterAllowlistMemberDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["account"] = doc.member.Account().String()
+	m["active"] = doc.member.Active()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type DAORolesDoc struct {
+	mongodbst.BaseDoc
+	st    base.State
+	roles statedao.RolesStateValue
+}
+
+func NewDAORolesDoc(st base.State, enc encoder.Encoder) (DAORolesDoc, error) {
+	roles, err := statedao.StateRolesValue(st)
+	if err != nil {
+		return DAORolesDoc{}, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAORolesDoc{}, err
+	}
+
+	return DAORolesDoc{
+		BaseDoc: b,
+		st:      st,
+		roles:   roles,
+	}, nil
+}
+
+func (doc DAORolesDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), statedao.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["account"] = doc.roles.Account().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -15,6 +15,14 @@ var daoServiceIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName(cdigest.IndexPrefix + "dao_service_contract_height"),
 	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "policy_version", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_service_contract_policy_version_height"),
+	},
 }
 
 var daoProposalIndexModels = []mongo.IndexModel{
@@ -26,6 +34,14 @@ var daoProposalIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName(cdigest.IndexPrefix + "dao_proposal_contract_height"),
 	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "proposal_sequence", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_proposal_contract_sequence_height"),
+	},
 }
 
 var daoDelegatorsIndexModels = []mongo.IndexModel{
@@ -60,6 +76,67 @@ var daoVotingPowerBoxIndexModels = []mongo.IndexModel{
 			SetName(cdigest.IndexPrefix + "dao_voting_power_contract_proposalID_height"),
 	},
 }
+
+var daoStatusHistoryIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "proposal_id", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_status_history_contract_proposalID_height"),
+	},
+}
+var daoTallyIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "proposal_id", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_tally_contract_proposalID_height"),
+	},
+}
+var daoSponsorsIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "proposal_id", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_sponsors_contract_proposalID_height"),
+	},
+}
+var daoWhitelistIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "account", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_whitelist_contract_account_height"),
+	},
+}
+var daoVoterAllowlistIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "account", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_voter_allowlist_contract_account_height"),
+	},
+}
+var daoRolesIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "account", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "dao_roles_contract_account_height"),
+	},
+}
 var DefaultIndexes = cdigest.DefaultIndexes
 
 func init() {
@@ -68,4 +145,10 @@ func init() {
 	DefaultIndexes[DefaultColNameDAODelegators] = daoDelegatorsIndexModels
 	DefaultIndexes[DefaultColNameDAOVoters] = daoVotersIndexModels
 	DefaultIndexes[DefaultColNameDAOVotingPowerBox] = daoVotingPowerBoxIndexModels
+	DefaultIndexes[DefaultColNameDAOStatusHistory] = daoStatusHistoryIndexModels
+	DefaultIndexes[DefaultColNameDAOTally] = daoTallyIndexModels
+	DefaultIndexes[DefaultColNameDAOSponsors] = daoSponsorsIndexModels
+	DefaultIndexes[DefaultColNameDAOWhitelist] = daoWhitelistIndexModels
+	DefaultIndexes[DefaultColNameDAOVoterAllowlist] = daoVoterAllowlistIndexModels
+	DefaultIndexes[DefaultColNameDAORoles] = daoRolesIndexModels
 }
@@ -40,9 +40,17 @@ func (Module) Register(reg *modulekit.Registry) error {
 		ID,
 		modulekit.APIRoute{Path: modapi.HandlerPathDAOService, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: modapi.HandlerPathDAOProposal, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOProposals, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: modapi.HandlerPathDAODelegator, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: modapi.HandlerPathDAOVoters, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: modapi.HandlerPathDAOVotingPowerBox, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOProposalTimeline, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOTally, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOSponsors, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOWhitelist, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOVoterAllowlist, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAORoles, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathDAOPolicy, Methods: []string{"GET"}},
 	); err != nil {
 		return err
 	}
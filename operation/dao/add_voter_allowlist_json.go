@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type AddVoterAllowlistFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	Accounts []base.Address    `json:"accounts"`
+	Currency ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact AddVoterAllowlistFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AddVoterAllowlistFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Accounts:              fact.accounts,
+		Currency:              fact.currency,
+	})
+}
+
+type AddVoterAllowlistFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string   `json:"sender"`
+	Contract string   `json:"contract"`
+	Accounts []string `json:"accounts"`
+	Currency string   `json:"currency"`
+}
+
+func (fact *AddVoterAllowlistFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf AddVoterAllowlistFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Accounts,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op AddVoterAllowlist) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *AddVoterAllowlist) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var addVoterAllowlistProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AddVoterAllowlistProcessor)
+	},
+}
+
+func (AddVoterAllowlist) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AddVoterAllowlistProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAddVoterAllowlistProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AddVoterAllowlistProcessor")
+
+		nopp := addVoterAllowlistProcessorPool.Get()
+		opp, ok := nopp.(*AddVoterAllowlistProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AddVoterAllowlistProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AddVoterAllowlistProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(AddVoterAllowlistFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", AddVoterAllowlistFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if err := cstate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	for _, ac := range fact.Accounts() {
+		if _, _, _, cErr := cstate.ExistsCAccount(ac, "voter", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: voter %v is contract account", cErr, ac)), nil
+		}
+
+		switch member, err := isVoterAllowlisted(fact.Contract(), ac, getStateFunc); {
+		case err != nil:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("voter allowlist member %v in contract account %v", ac, fact.Contract())), nil
+		case member:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v already in voter allowlist of contract account %v", ac, fact.Contract())), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AddVoterAllowlistProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process AddVoterAllowlist")
+
+	fact, ok := op.Fact().(AddVoterAllowlistFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected AddVoterAllowlistFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue
+
+	for _, ac := range fact.Accounts() {
+		smv, err := cstate.CreateNotExistAccount(ac, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	sts = append(sts, voterAllowlistMemberStateMergeValues(fact.Contract(), fact.Accounts(), true)...)
+
+	return sts, nil, nil
+}
+
+func (opp *AddVoterAllowlistProcessor) Close() error {
+	addVoterAllowlistProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	AddWhitelistFactHint = hint.MustNewHint("mitum-dao-add-whitelist-operation-fact-v0.0.1")
+	AddWhitelistHint     = hint.MustNewHint("mitum-dao-add-whitelist-operation-v0.0.1")
+)
+
+type AddWhitelistFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	accounts []base.Address
+	currency types.CurrencyID
+}
+
+func NewAddWhitelistFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	accounts []base.Address,
+	currency types.CurrencyID,
+) AddWhitelistFact {
+	bf := base.NewBaseFact(AddWhitelistFactHint, token)
+	fact := AddWhitelistFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		accounts: accounts,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AddWhitelistFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AddWhitelistFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AddWhitelistFact) Bytes() []byte {
+	ads := make([][]byte, len(fact.accounts))
+	for i := range fact.accounts {
+		ads[i] = fact.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(ads...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AddWhitelistFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidWhitelistAccounts(fact.contract, fact.accounts); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact AddWhitelistFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AddWhitelistFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AddWhitelistFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact AddWhitelistFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+func (fact AddWhitelistFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact AddWhitelistFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2+len(fact.accounts))
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	for i, ac := range fact.accounts {
+		as[i+2] = ac
+	}
+
+	return as, nil
+}
+
+func (fact AddWhitelistFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact AddWhitelistFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact AddWhitelistFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact AddWhitelistFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact AddWhitelistFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact AddWhitelistFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	keys := make([]string, len(fact.accounts))
+	for i, ac := range fact.accounts {
+		keys[i] = fmt.Sprintf("%s:%s", fact.Contract().String(), ac.String())
+	}
+	r[processor.DuplicationTypeDAOContractWhitelist] = keys
+
+	return r, nil
+}
+
+type AddWhitelist struct {
+	extras.ExtendedOperation
+}
+
+func (op AddWhitelist) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewAddWhitelist(fact AddWhitelistFact) AddWhitelist {
+	return AddWhitelist{
+		ExtendedOperation: extras.NewExtendedOperation(AddWhitelistHint, fact),
+	}
+}
+
+func isValidWhitelistAccounts(contract base.Address, accounts []base.Address) error {
+	if len(accounts) < 1 {
+		return common.ErrValOOR.Wrap(errors.Errorf("empty whitelist accounts"))
+	}
+
+	if len(accounts) > daotypes.MaxWhitelistItems {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("whitelist accounts over max, %d > %d", len(accounts), daotypes.MaxWhitelistItems))
+	}
+
+	founds := map[string]struct{}{}
+	for _, ac := range accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return err
+		}
+
+		if ac.Equal(contract) {
+			return common.ErrSelfTarget.Wrap(errors.Errorf("whitelist account %v is same with contract account", ac))
+		}
+
+		if _, found := founds[ac.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("whitelist account %v", ac))
+		}
+		founds[ac.String()] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	AmendProposalFactHint = hint.MustNewHint("mitum-dao-amend-proposal-operation-fact-v0.0.1")
+	AmendProposalHint     = hint.MustNewHint("mitum-dao-amend-proposal-operation-v0.0.1")
+)
+
+// AmendProposalFact replaces the body of the proposal with a new one
+// while the proposal is in the review period.
+type AmendProposalFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	proposal   types.Proposal
+	currency   ctypes.CurrencyID
+}
+
+func NewAmendProposalFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	proposal types.Proposal,
+	currency ctypes.CurrencyID,
+) AmendProposalFact {
+	bf := base.NewBaseFact(AmendProposalFactHint, token)
+	fact := AmendProposalFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		proposal:   proposal,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AmendProposalFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AmendProposalFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AmendProposalFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.proposal.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AmendProposalFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.proposal,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(
+				errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if !fact.sender.Equal(fact.Proposal().Proposer()) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(errors.Errorf("sender %v is not same with the proposer of proposal", fact.sender)))
+	}
+
+	if len(fact.proposalID) == 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("empty proposal ID")))
+	}
+
+	if !ctypes.ReValidSpcecialCh.Match([]byte(fact.proposalID)) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(common.ErrValueInvalid.Wrap(
+				errors.Errorf("proposal ID %v must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.proposalID))))
+	}
+
+	for _, d := range fact.proposal.Dependencies() {
+		if d == fact.proposalID {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("proposal %q depends on itself", fact.proposalID)))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact AmendProposalFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AmendProposalFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AmendProposalFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact AmendProposalFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact AmendProposalFact) Proposal() types.Proposal {
+	return fact.proposal
+}
+
+func (fact AmendProposalFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact AmendProposalFact) Addresses() ([]base.Address, error) {
+	as := fact.proposal.Addresses()
+
+	as = append(as, fact.sender)
+	as = append(as, fact.contract)
+
+	return as, nil
+}
+
+func (fact AmendProposalFact) FeeBase() (ctypes.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact AmendProposalFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact AmendProposalFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact AmendProposalFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact AmendProposalFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact AmendProposalFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[processor.DuplicationTypeDAOContractProposal] = []string{fmt.Sprintf("%s:%s", fact.Contract().String(), fact.ProposalID())}
+
+	return r, nil
+}
+
+type AmendProposal struct {
+	extras.ExtendedOperation
+}
+
+func (op AmendProposal) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewAmendProposal(fact AmendProposalFact) AmendProposal {
+	return AmendProposal{
+		ExtendedOperation: extras.NewExtendedOperation(AmendProposalHint, fact),
+	}
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *AmendProposalFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string,
+	bp []byte,
+	cid string,
+) error {
+	fact.proposalID = pid
+	fact.currency = ctypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return err
+	} else if proposal, ok := hinter.(types.Proposal); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Proposal, not %T", hinter))
+	} else {
+		fact.proposal = proposal
+	}
+
+	return nil
+}
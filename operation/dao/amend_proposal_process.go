@@ -0,0 +1,241 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var amendProposalProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AmendProposalProcessor)
+	},
+}
+
+func (AmendProposal) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AmendProposalProcessor struct {
+	*base.BaseOperationProcessor
+	proposal *base.ProposalSignFact
+}
+
+func NewAmendProposalProcessor() ctypes.GetNewProcessorWithProposal {
+	return func(
+		height base.Height,
+		proposal *base.ProposalSignFact,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AmendProposalProcessor")
+
+		nopp := amendProposalProcessorPool.Get()
+		opp, ok := nopp.(*AmendProposalProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AmendProposalProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposal = proposal
+
+		return opp, nil
+	}
+}
+
+func (opp *AmendProposalProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(AmendProposalFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", AmendProposalFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	} else if _, err := state.StateDesignValue(st); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	st, err := cstate.ExistsState(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if !fact.Sender().Equal(p.Proposal().Proposer()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not proposer of the proposal %q in contract account %v", fact.Sender(), fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if p.Status() != types.Proposed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v is %q, but can only be amended at \"proposed\" status",
+					fact.ProposalID(), fact.Contract(), p.Status())), nil
+	}
+
+	if len(p.Amendments()) >= types.MaxProposalAmendments {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("proposal %q in contract account %v is already amended %d times",
+					fact.ProposalID(), fact.Contract(), len(p.Amendments()))), nil
+	}
+
+	if p.Proposal().Option() != fact.Proposal().Option() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal option can not be amended, %s != %s", p.Proposal().Option(), fact.Proposal().Option())), nil
+	}
+
+	// the periods of the proposal are counted from its start time.
+	if p.Proposal().StartTime() != fact.Proposal().StartTime() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal start time can not be amended, %d != %d",
+					p.Proposal().StartTime(), fact.Proposal().StartTime())), nil
+	}
+
+	if types.IsOptimistic(fact.Proposal()) && p.Policy().ObjectionThreshold() == 0 {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("optimistic proposal is not allowed without objection threshold in contract account %v", fact.Contract())), nil
+	}
+
+	// turnout is waived only for the routine treasury payouts.
+	if cp, ok := fact.Proposal().(types.CryptoProposal); ok && cp.Optimistic() && cp.CallData().Type() != types.CalldataTransfer {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("optimistic proposal is allowed only for %s calldata, not %s", types.CalldataTransfer, cp.CallData().Type())), nil
+	}
+
+	for _, d := range fact.Proposal().Dependencies() {
+		if _, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), d), "dependency proposal", getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("dependency proposal %q in contract account %v", d, fact.Contract())), nil
+		}
+	}
+
+	if found, _ := cstate.CheckNotExistsState(state.StateKeyDelegators(fact.Contract(), fact.ProposalID()), getStateFunc); found {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v already has registrants", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AmendProposalProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(AmendProposalFact)
+
+	st, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	proposal := *opp.proposal
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.ProposalReview, nowTime)
+	if period != types.ProposalReview {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"current time is not within the ProposalReview period; start(%d), end(%d), but now(%d)", start, end, nowTime), nil
+	}
+
+	amendments := make([]types.Amendment, len(p.Amendments()), len(p.Amendments())+1)
+	copy(amendments, p.Amendments())
+	amendments = append(amendments, types.NewAmendment(p.Proposal(), opp.Height(), fact.Hash()))
+
+	sts := []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			st.Key(),
+			state.NewProposalStateValue(
+				p.Status(), p.Reason(), p.ReasonDetail(),
+				fact.Proposal(), p.Sequence(), amendments, p.Policy(), p.PolicyVersion(), p.Deposit(),
+			),
+		),
+	}
+
+	// the sponsors backed the body before the amendment; the amended proposal is sponsored again.
+	if !bytes.Equal(fact.Proposal().Bytes(), p.Proposal().Bytes()) {
+		switch _, found, err := getStateFunc(state.StateKeySponsors(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get sponsors, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case found:
+			sts = append(sts, cstate.NewStateMergeValue(
+				state.StateKeySponsors(fact.Contract(), fact.ProposalID()),
+				state.NewSponsorsStateValue(nil),
+			))
+		}
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *AmendProposalProcessor) Close() error {
+	opp.proposal = nil
+	amendProposalProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestAmendProposalClearsSponsors(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	proposer := d.newAccount("proposer", 100)
+	sponsor := d.newAccount("sponsor", 100)
+
+	d.setProposal("1", proposer.Address(), types.Proposed,
+		types.NewDeposit(proposer.Address(), d.amount(1), types.DepositLocked))
+	d.setState(
+		state.StateKeySponsors(d.contract, "1"),
+		state.NewSponsorsStateValue([]types.SponsorInfo{types.NewSponsorInfo(sponsor.Address(), base.Height(1))}),
+	)
+
+	amend := func(proposal types.Proposal) {
+		t.Helper()
+
+		// the review period of the proposal is from 100 to 110.
+		p := NewTestAmendProposalProcessor(d.tp)
+		p.Create(blockMaps(105)).
+			MakeOperation(proposer.Address(), proposer.Priv(), d.contract, "1", proposal, d.tp.GenesisCurrency).
+			RunPreProcess()
+		if err := p.Error(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := p.RunProcess().Error(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sponsors := func() []types.SponsorInfo {
+		t.Helper()
+
+		st, _, _ := d.tp.GetStateFunc(state.StateKeySponsors(d.contract, "1"))
+		sponsors, err := state.StateSponsorsValue(st)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return sponsors
+	}
+
+	amend(d.proposal(t, "1").Proposal())
+
+	if n := len(sponsors()); n != 1 {
+		t.Fatalf("expected the sponsors kept by the amendment without changes, got %d", n)
+	}
+
+	amend(types.NewCryptoProposal(
+		proposer.Address(), 100,
+		types.NewTransferCallData(d.contract, sponsor.Address(), d.amount(2)),
+		false, nil,
+	))
+
+	if n := len(sponsors()); n != 0 {
+		t.Fatalf("expected the sponsors cleared by the amendment, got %d", n)
+	}
+}
@@ -112,10 +112,11 @@ func (opp *CancelProposalProcessor) PreProcess(
 				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
 	}
 
+	// a sender other than the proposer needs the admin role.
 	if !fact.Sender().Equal(p.Proposal().Proposer()) {
-		return ctx, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
-				Errorf("sender %v is not proposer of the proposal %q in contract account %v", fact.Sender(), fact.ProposalID(), fact.Contract())), nil
+		if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+			return ctx, err, nil
+		}
 	}
 
 	if p.Status() == types.Canceled {
@@ -123,10 +124,10 @@ func (opp *CancelProposalProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
 				Errorf("already canceled proposal %q in contract account %v",
 					fact.ProposalID(), fact.Contract())), nil
-	} else if p.Status() != types.Proposed {
+	} else if p.Status() != types.Proposed && p.Status() != types.PendingDeposit {
 		return ctx, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
-				Errorf("proposal %q in contract account %v is %q, but can only be canceled at \"proposed\" status",
+				Errorf("proposal %q in contract account %v is %q, but can only be canceled at \"proposed\" or \"pending-deposit\" status",
 					fact.ProposalID(), fact.Contract(), p.Status())), nil
 	}
 
@@ -150,20 +151,46 @@ func (opp *CancelProposalProcessor) Process(
 	}
 
 	proposal := *opp.proposal
-	nowTime := uint64(proposal.ProposalFact().ProposedAt().Unix())
-
-	period, start, _ := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Voting, nowTime)
-	if !(period == types.PreLifeCycle || period == types.ProposalReview || period == types.Registration) {
-		return nil, base.NewBaseOperationProcessReasonError("cancellable period has passed; voting-started(%d), now(%d)", start, nowTime), nil
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	action := p.Policy().DepositRule().OnWithdrawn()
+
+	// contributors of a deposit which has not reached the target always get their contributions back.
+	if p.Status() == types.PendingDeposit {
+		action = types.DepositRefund
+	} else {
+		period, start, _ := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Voting, nowTime)
+		if !(period == types.PreLifeCycle || period == types.DepositCollection ||
+			period == types.ProposalReview || period == types.Registration) {
+			return nil, base.NewBaseOperationProcessReasonError("cancellable period has passed; voting-started(%d), now(%d)", start, nowTime), nil
+		}
 	}
 
-	var sts []base.StateMergeValue
+	sts, deposit, err := settleDeposit(
+		fact.Contract(), fact.ProposalID(), p.Deposit(), action, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
 
 	sts = append(sts, cstate.NewStateMergeValue(
 		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-		state.NewProposalStateValue(types.Canceled, "cancel operation processed", p.Proposal(), p.Policy()),
+		state.NewProposalStateValue(
+			types.Canceled, "cancel operation processed", types.NewReasonDetail(types.ReasonCanceledByProposer),
+			p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+		),
 	))
 
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), fact.ProposalID(), p.Status(), types.Canceled, types.ReasonCanceledByProposer,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+	sts = append(sts, hst...)
+
 	return sts, nil, nil
 }
 
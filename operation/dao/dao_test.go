@@ -0,0 +1,252 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+// testPolicy holds the policy fields the tests change; the periods are 10 seconds each.
+type testPolicy struct {
+	depositRule          types.DepositRule
+	depositPeriod        uint64
+	depositTarget        common.Big
+	guardians            types.Guardians
+	maxActiveProposals   uint64
+	maxProposerProposals uint64
+	executors            types.Executors
+	objectionThreshold   types.PercentRatio
+	reviewers            types.Reviewers
+	minLeadTime          uint64
+}
+
+func (c testPolicy) Policy(cid ctypes.CurrencyID) types.Policy {
+	depositRule := c.depositRule
+	if depositRule.Hint().IsEmpty() {
+		depositRule = types.NewDepositRule(types.DepositKeep, types.DepositKeep, types.DepositKeep, types.DepositKeep)
+	}
+
+	depositTarget := common.ZeroBig
+	if c.depositTarget.OverZero() {
+		depositTarget = c.depositTarget
+	}
+
+	guardians := c.guardians
+	if guardians.Hint().IsEmpty() {
+		guardians = types.NewGuardians(nil, 0, common.ZeroBig)
+	}
+
+	reviewers := c.reviewers
+	if reviewers.Hint().IsEmpty() {
+		reviewers = types.NewReviewers(nil)
+	}
+
+	executors := c.executors
+	if executors.Hint().IsEmpty() {
+		executors = types.NewExecutors(nil)
+	}
+
+	return types.NewPolicy(
+		cid,
+		common.NewBig(1),
+		ctypes.NewAmount(common.NewBig(1), cid),
+		types.NewWhitelist(false, nil),
+		10, 10, 10, 10, 10, 10,
+		types.PercentRatio(1), types.PercentRatio(1),
+		depositRule,
+		c.depositPeriod,
+		depositTarget,
+		guardians,
+		c.objectionThreshold,
+		0, 0, 0,
+		reviewers,
+		false,
+		types.PeriodUnitSecond,
+		c.minLeadTime, 0,
+		c.maxActiveProposals, c.maxProposerProposals,
+		executors,
+	)
+}
+
+// testDAO is a dao of a contract account owned by owner with its accounts and a block
+// proposed at a given time.
+type testDAO struct {
+	tp       *test.TestProcessor
+	owner    test.Account
+	contract base.Address
+	policy   types.Policy
+}
+
+func newTestDAO(t *testing.T, c testPolicy) *testDAO {
+	t.Helper()
+
+	tp := new(test.TestProcessor)
+	tp.Setup(test.NewMockStateGetter())
+
+	d := &testDAO{tp: tp}
+
+	d.owner = d.newAccount("owner", 100)
+	d.contract, _ = tp.NewTestContractAccountState(d.owner.Address(), tp.NewPrivateKey("contract"), true)
+	d.setPolicy(c)
+
+	return d
+}
+
+// setPolicy replaces the dao design with the policy; the proposals stored after keep it.
+func (d *testDAO) setPolicy(c testPolicy) {
+	d.policy = c.Policy(d.tp.GenesisCurrency)
+
+	d.setState(
+		state.StateKeyDesign(d.contract),
+		state.NewDesignStateValue(
+			types.NewDesign(types.ProposalCrypto, d.policy, types.NewUnboundedPolicyBounds()),
+			false, 1, 0, valuehash.RandomSHA256(),
+		),
+	)
+}
+
+func (d *testDAO) newAccount(seed string, amount int64) test.Account {
+	accounts := make([]test.Account, 1)
+	d.tp.SetAccount(d.tp.NewPrivateKey(seed), amount, d.tp.GenesisCurrency, accounts, true)
+
+	return accounts[0]
+}
+
+func (d *testDAO) setState(key string, value base.StateValue) {
+	d.tp.SetState(common.NewBaseState(base.Height(1), key, value, nil, []util.Hash{}), true)
+}
+
+// newProposal returns a transfer proposal from the contract account.
+func (d *testDAO) newProposal(proposer base.Address, startTime uint64) types.Proposal {
+	receiver := d.newAccount("receiver", 0)
+
+	return types.NewCryptoProposal(
+		proposer, startTime,
+		types.NewTransferCallData(d.contract, receiver.Address(), d.amount(1)),
+		false, nil,
+	)
+}
+
+// setProposal stores a transfer proposal which starts at 100.
+func (d *testDAO) setProposal(pid string, proposer base.Address, status types.ProposalStatus, deposit types.Deposit) {
+	d.setState(
+		state.StateKeyProposal(d.contract, pid),
+		state.NewProposalStateValue(
+			status, "", types.NewReasonDetail(types.ReasonProposed),
+			d.newProposal(proposer, 100), 0, nil, d.policy, 0, deposit,
+		),
+	)
+}
+
+// setGovernanceProposal stores a completed governance proposal which starts at 100 and restates
+// the policy; it can be executed from 160.
+func (d *testDAO) setGovernanceProposal(pid string) {
+	proposer := d.newAccount("proposer", 0)
+
+	d.setState(
+		state.StateKeyProposal(d.contract, pid),
+		state.NewProposalStateValue(
+			types.Completed, "", types.NewReasonDetail(types.ReasonProposed),
+			types.NewCryptoProposal(
+				proposer.Address(), 100,
+				types.NewGovernanceCallData(types.NewPolicyPatchFromPolicy(d.policy)),
+				false, nil,
+			),
+			0, nil, d.policy, 1,
+			types.NewDeposit(proposer.Address(), d.amount(0), types.DepositKept),
+		),
+	)
+	d.setState(
+		state.StateKeyVotingPowerBox(d.contract, pid),
+		state.NewVotingPowerBoxStateValue(types.NewVotingPowerBox(common.ZeroBig, nil)),
+	)
+}
+
+// setBalance sets the contract balance to the treasury and the locked deposits.
+func (d *testDAO) setBalance(treasury, locked int64) {
+	d.tp.NewTestBalanceState(d.contract, d.tp.GenesisCurrency, treasury+locked, true)
+	d.setState(
+		state.StateKeyLockedDeposit(d.contract, d.tp.GenesisCurrency),
+		state.NewLockedDepositStateValue(d.amount(locked)),
+	)
+}
+
+// merge merges the state merge values into the states, like the block does.
+func (d *testDAO) merge(t *testing.T, sts []base.StateMergeValue) {
+	t.Helper()
+
+	for i := range sts {
+		st, _, _ := d.tp.GetStateFunc(sts[i].Key())
+		merger := sts[i].Merger(base.Height(1), st)
+		if err := merger.Merge(sts[i].Value(), nil); err != nil {
+			t.Fatal(err)
+		}
+
+		nst, err := merger.CloseValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.tp.SetState(nst, true)
+	}
+}
+
+func (d *testDAO) lockedDeposit(t *testing.T) common.Big {
+	t.Helper()
+
+	locked, err := lockedDeposit(d.contract, d.tp.GenesisCurrency, d.tp.GetStateFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return locked
+}
+
+// blockMaps returns the block maps of a block proposed at the time in seconds.
+func blockMaps(proposedAt int64) []base.BlockMap {
+	return []base.BlockMap{BlockMap{manifest: Manifest{proposedAt: time.Unix(proposedAt, 0)}}}
+}
+
+func (d *testDAO) proposal(t *testing.T, pid string) state.ProposalStateValue {
+	t.Helper()
+
+	st, found, _ := d.tp.GetStateFunc(state.StateKeyProposal(d.contract, pid))
+	if !found {
+		t.Fatalf("proposal %q not found", pid)
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func (d *testDAO) balance(t *testing.T, account base.Address) common.Big {
+	t.Helper()
+
+	st, found, _ := d.tp.GetStateFunc(currency.BalanceStateKey(account, d.tp.GenesisCurrency))
+	if !found {
+		return common.ZeroBig
+	}
+
+	b, err := currency.StateBalanceValue(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b.Big()
+}
+
+func (d *testDAO) amount(n int64) ctypes.Amount {
+	return ctypes.NewAmount(common.NewBig(n), d.tp.GenesisCurrency)
+}
@@ -0,0 +1,53 @@
+package dao
+
+import (
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// isFailedDependencyStatus reports whether the dependency proposal has ended
+// without being executed and can never be executed.
+func isFailedDependencyStatus(status types.ProposalStatus) bool {
+	switch status {
+	case types.Canceled, types.Rejected, types.Vetoed, types.Lapsed, types.Expired:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkDependencies returns the first dependency of the proposal which is not
+// executed yet and the first dependency which has failed.
+func checkDependencies(
+	contract base.Address,
+	dependencies []string,
+	getStateFunc base.GetStateFunc,
+) (pending string, failed string, _ error) {
+	for _, d := range dependencies {
+		st, err := cstate.ExistsState(state.StateKeyProposal(contract, d), "dependency proposal", getStateFunc)
+		if err != nil {
+			return "", "", err
+		}
+
+		p, err := state.StateProposalValue(st)
+		if err != nil {
+			return "", "", err
+		}
+
+		switch status := p.Status(); {
+		case status == types.Executed:
+		case isFailedDependencyStatus(status):
+			if len(failed) == 0 {
+				failed = d
+			}
+		default:
+			if len(pending) == 0 {
+				pending = d
+			}
+		}
+	}
+
+	return pending, failed, nil
+}
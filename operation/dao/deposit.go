@@ -0,0 +1,204 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	DepositFactHint = hint.MustNewHint("mitum-dao-deposit-operation-fact-v0.0.1")
+	DepositHint     = hint.MustNewHint("mitum-dao-deposit-operation-v0.0.1")
+)
+
+type DepositFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	amount     types.Amount
+	currency   types.CurrencyID
+}
+
+func NewDepositFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	amount types.Amount,
+	currency types.CurrencyID,
+) DepositFact {
+	bf := base.NewBaseFact(DepositFactHint, token)
+	fact := DepositFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		amount:     amount,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact DepositFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact DepositFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DepositFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.amount.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact DepositFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.amount,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if len(fact.proposalID) == 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("empty proposal ID")))
+	}
+
+	if !types.ReValidSpcecialCh.Match([]byte(fact.proposalID)) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(
+				errors.Errorf("proposal ID %v must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.proposalID)))
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("deposit amount must be bigger than zero, got %v", fact.amount.Big())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact DepositFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact DepositFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DepositFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact DepositFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact DepositFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact DepositFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact DepositFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+func (fact DepositFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact DepositFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact DepositFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact DepositFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact DepositFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+// DupKey takes a deposit of the sender to the proposal, so the deposits of the different senders to
+// a proposal can be in the same block.
+func (fact DepositFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[processor.DuplicationTypeDAOContractDeposit] = []string{
+		fmt.Sprintf("%s:%s:%s", fact.Contract().String(), fact.ProposalID(), fact.Sender().String()),
+	}
+
+	return r, nil
+}
+
+// SharedDupKey shares the proposal with the other deposits to it, but keeps the deposits out of the
+// block of the other operations on the proposal, like a cancel.
+func (fact DepositFact) SharedDupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[processor.DuplicationTypeDAOContractProposal] = []string{fmt.Sprintf("%s:%s", fact.Contract().String(), fact.ProposalID())}
+
+	return r, nil
+}
+
+type Deposit struct {
+	extras.ExtendedOperation
+}
+
+func (op Deposit) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewDeposit(fact DepositFact) Deposit {
+	return Deposit{
+		ExtendedOperation: extras.NewExtendedOperation(DepositHint, fact),
+	}
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *DepositFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string,
+	bam []byte,
+	cid string,
+) error {
+	fact.proposalID = pid
+	fact.currency = ctypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return err
+	} else if am, ok := hinter.(ctypes.Amount); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		fact.amount = am
+	}
+
+	return nil
+}
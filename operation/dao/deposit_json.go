@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type DepositFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address      `json:"sender"`
+	Contract   base.Address      `json:"contract"`
+	ProposalID string            `json:"proposal_id"`
+	Amount     ctypes.Amount     `json:"amount"`
+	Currency   ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact DepositFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Amount:                fact.amount,
+		Currency:              fact.currency,
+	})
+}
+
+type DepositFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string          `json:"sender"`
+	Contract   string          `json:"contract"`
+	ProposalID string          `json:"proposal_id"`
+	Amount     json.RawMessage `json:"amount"`
+	Currency   string          `json:"currency"`
+}
+
+func (fact *DepositFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf DepositFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Amount,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op Deposit) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *Deposit) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var depositProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DepositProcessor)
+	},
+}
+
+func (Deposit) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type DepositProcessor struct {
+	*base.BaseOperationProcessor
+	proposal *base.ProposalSignFact
+}
+
+func NewDepositProcessor() ctypes.GetNewProcessorWithProposal {
+	return func(
+		height base.Height,
+		proposal *base.ProposalSignFact,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new DepositProcessor")
+
+		nopp := depositProcessorPool.Get()
+		opp, ok := nopp.(*DepositProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected DepositProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposal = proposal
+
+		return opp, nil
+	}
+}
+
+func (opp *DepositProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(DepositFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", DepositFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	} else if _, err := state.StateDesignValue(st); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	st, err := cstate.ExistsState(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if p.Status() != types.PendingDeposit {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v is %q, but deposit is only accepted at \"pending-deposit\" status",
+					fact.ProposalID(), fact.Contract(), p.Status())), nil
+	}
+
+	if cid := p.Deposit().Amount().Currency(); fact.Amount().Currency() != cid {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("deposit currency of proposal %q in contract account %v is %q, not %q",
+					fact.ProposalID(), fact.Contract(), cid, fact.Amount().Currency())), nil
+	}
+
+	st, err = cstate.ExistsState(
+		currency.BalanceStateKey(fact.Sender(), fact.Amount().Currency()), "sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("sender %v balance for currency id %q", fact.Sender(), fact.Amount().Currency())), nil
+	}
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("sender %v balance for currency id %q", fact.Sender(), fact.Amount().Currency())), nil
+	case b.Big().Compare(fact.Amount().Big()) < 0:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("not enough balance of sender %v for currency id %q", fact.Sender(), fact.Amount().Currency())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *DepositProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(DepositFact)
+
+	st, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s,%v: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	proposal := *opp.proposal
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	// the deposits are taken only in the deposit period. The unfunded proposal lapses by the other
+	// operations on it after the deposit period, which can not be in the block of the deposits.
+	switch period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.DepositCollection, nowTime); period {
+	case types.PreLifeCycle:
+		return nil, base.NewBaseOperationProcessReasonError("deposit period not started yet; deposit-started(%d), now(%d)", start, nowTime), nil
+	case types.DepositCollection:
+	default:
+		return nil, base.NewBaseOperationProcessReasonError("deposit period is over; deposit-ended(%d), now(%d)", end, nowTime), nil
+	}
+
+	amount := fact.Amount()
+
+	var sts []base.StateMergeValue
+
+	cKey := state.StateKeyDepositContributions(fact.Contract(), fact.ProposalID())
+	sts = append(sts, common.NewBaseStateMergeValue(
+		cKey,
+		state.NewAddDepositContributionStateValue(types.NewDepositContribution(fact.Sender(), amount)),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return state.NewDepositContributionsStateValueMerger(height, cKey, st)
+		},
+	))
+
+	sBalanceKey := currency.BalanceStateKey(fact.Sender(), amount.Currency())
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			sBalanceKey,
+			currency.NewDeductBalanceStateValue(amount),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, sBalanceKey, amount.Currency(), st)
+			},
+		),
+	)
+
+	cBalanceKey := currency.BalanceStateKey(fact.Contract(), amount.Currency())
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			cBalanceKey,
+			currency.NewAddBalanceStateValue(amount),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, cBalanceKey, amount.Currency(), st)
+			},
+		),
+		lockedDepositStateMergeValue(fact.Contract(), state.NewLockDepositStateValue(amount), amount.Currency()),
+	)
+
+	// the proposal is proposed once the deposits reach the deposit target, but its review period
+	// still starts at the end of the deposit period; the periods of a proposal are fixed from its
+	// start time.
+	pKey := state.StateKeyProposal(fact.Contract(), fact.ProposalID())
+	hKey := state.StateKeyStatusHistory(fact.Contract(), fact.ProposalID())
+	deposited, target := p.Deposit().Amount().Big(), p.Policy().DepositTarget()
+
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			pKey,
+			state.NewAddDepositStateValue(amount, fact.Hash()),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewProposalDepositStateValueMerger(height, pKey, st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			hKey,
+			state.NewAddDepositStateValue(amount, fact.Hash()),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewDepositStatusHistoryStateValueMerger(height, hKey, st, deposited, target)
+			},
+		),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *DepositProcessor) Close() error {
+	opp.proposal = nil
+	depositProcessorPool.Put(opp)
+
+	return nil
+}
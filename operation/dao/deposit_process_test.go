@@ -0,0 +1,179 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// deposit returns the Deposit of the amount to the proposal signed by sender.
+func (d *testDAO) deposit(sender test.Account, pid string, amount int64) base.Operation {
+	p := NewTestDepositProcessor(d.tp)
+	p.MakeOperation(sender.Address(), sender.Priv(), d.contract, pid, d.amount(amount), d.tp.GenesisCurrency)
+
+	return p.Op
+}
+
+// processDeposits processes the deposits on the states before the block proposed at the time, and
+// merges their state merge values of each key in the order of the deposits, like the block does.
+func (d *testDAO) processDeposits(t *testing.T, proposedAt int64, ops ...base.Operation) {
+	t.Helper()
+
+	var keys []string
+	values := map[string][]base.StateMergeValue{}
+	owners := map[string][]base.Operation{}
+
+	for i := range ops {
+		p := NewTestDepositProcessor(d.tp)
+		p.Create(blockMaps(proposedAt))
+
+		if _, reason, err := p.Opr.PreProcess(context.Background(), ops[i], d.tp.GetStateFunc); err != nil {
+			t.Fatal(err)
+		} else if reason != nil {
+			t.Fatal(reason)
+		}
+
+		sts, reason, err := p.Opr.Process(context.Background(), ops[i], d.tp.GetStateFunc)
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case reason != nil:
+			t.Fatal(reason)
+		}
+
+		for j := range sts {
+			k := sts[j].Key()
+			if _, found := values[k]; !found {
+				keys = append(keys, k)
+			}
+
+			values[k] = append(values[k], sts[j])
+			owners[k] = append(owners[k], ops[i])
+		}
+	}
+
+	for _, k := range keys {
+		st, _, _ := d.tp.GetStateFunc(k)
+		merger := values[k][0].Merger(base.Height(1), st)
+
+		for i := range values[k] {
+			if err := merger.Merge(values[k][i].Value(), owners[k][i].Hash()); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		nst, err := merger.CloseValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.tp.SetState(nst, true)
+	}
+}
+
+func TestDepositsOfSendersShareProposal(t *testing.T) {
+	d, alice, bob := newTestUnfundedDAO(t)
+
+	cancel := NewTestCancelProposalProcessor(d.tp)
+	cancel.MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, "1", d.tp.GenesisCurrency)
+
+	errs := d.checkDuplication(
+		d.deposit(alice, "1", 1),
+		d.deposit(bob, "1", 1),
+		d.deposit(alice, "1", 2),
+		cancel.Op,
+	)
+
+	for i := range errs[:2] {
+		if errs[i] != nil {
+			t.Fatalf("expected the deposits of the different senders in a block, %v", errs[i])
+		}
+	}
+
+	if errs[2] == nil {
+		t.Fatal("expected the second deposit of the sender not in the block")
+	}
+
+	if errs[3] == nil {
+		t.Fatal("expected the cancel not in the block of the deposits")
+	}
+
+	if errs := d.checkDuplication(cancel.Op, d.deposit(alice, "1", 1)); errs[1] == nil {
+		t.Fatal("expected the deposit not in the block of the cancel")
+	}
+}
+
+func TestDepositsMergeContributions(t *testing.T) {
+	d, alice, bob := newTestUnfundedDAO(t)
+	carol := d.newAccount("carol", 10)
+	d.tp.NewTestBalanceState(alice.Address(), d.tp.GenesisCurrency, 10, true)
+
+	second := d.deposit(alice, "1", 4)
+	d.processDeposits(t, 105, d.deposit(carol, "1", 3), second)
+
+	st, _, _ := d.tp.GetStateFunc(state.StateKeyDepositContributions(d.contract, "1"))
+	contributions, err := state.StateDepositContributionsValue(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]int64{alice.Address().String(): 7, bob.Address().String(): 2, carol.Address().String(): 3}
+	if len(contributions) != len(expected) {
+		t.Fatalf("expected %d contributions, got %d", len(expected), len(contributions))
+	}
+
+	for _, c := range contributions {
+		if n := expected[c.Contributor().String()]; !c.Amount().Big().Equal(common.NewBig(n)) {
+			t.Fatalf("expected %d contributed by %v, got %v", n, c.Contributor(), c.Amount().Big())
+		}
+	}
+
+	pv := d.proposal(t, "1")
+	if pv.Status() != types.Proposed {
+		t.Fatalf("expected the proposal proposed by the deposits over the target, got %v", pv.Status())
+	}
+
+	if total := pv.Deposit().Amount().Big(); !total.Equal(common.NewBig(12)) {
+		t.Fatalf("expected 12 deposited, got %v", total)
+	}
+
+	if locked := d.lockedDeposit(t); !locked.Equal(common.NewBig(12)) {
+		t.Fatalf("expected 12 locked, got %v", locked)
+	}
+
+	st, _, _ = d.tp.GetStateFunc(state.StateKeyStatusHistory(d.contract, "1"))
+	transitions, err := state.StateStatusHistoryValue(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last := transitions[len(transitions)-1]
+	if last.To() != types.Proposed || !last.FactHash().Equal(second.Fact().Hash()) {
+		t.Fatal("expected the transition to proposed by the deposit which reached the target")
+	}
+}
+
+func TestDepositAfterDepositPeriod(t *testing.T) {
+	d, alice, _ := newTestUnfundedDAO(t)
+	d.tp.NewTestBalanceState(alice.Address(), d.tp.GenesisCurrency, 10, true)
+
+	p := NewTestDepositProcessor(d.tp)
+	p.Create(blockMaps(115)).
+		MakeOperation(alice.Address(), alice.Priv(), d.contract, "1", d.amount(5), d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.RunProcess().Error() == nil {
+		t.Fatal("expected the deposit not taken after the deposit period")
+	}
+
+	if pv := d.proposal(t, "1"); pv.Status() != types.PendingDeposit {
+		t.Fatalf("expected pending deposit proposal, got %v", pv.Status())
+	}
+}
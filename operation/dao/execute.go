@@ -7,6 +7,8 @@ import (
 	"github.com/imfact-labs/currency-model/operation/extras"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/dao-model/state"
+	daotypes "github.com/imfact-labs/dao-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
@@ -156,6 +158,37 @@ func (fact ExecuteFact) DupKey() (map[types.DuplicationKeyType][]string, error)
 	return r, nil
 }
 
+// StateDupKey takes the contract status of the contract for a governance proposal, which writes
+// the dao design like the admin operations, and the treasury of the calldata sender for a transfer
+// proposal, which spends the balance checked before the block.
+func (fact ExecuteFact) StateDupKey(getStateFunc base.GetStateFunc) (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	st, found, err := getStateFunc(state.StateKeyProposal(fact.Contract(), fact.ProposalID()))
+	if err != nil || !found {
+		return r, err
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return r, nil
+	}
+
+	cp, ok := p.Proposal().(daotypes.CryptoProposal)
+	if !ok {
+		return r, nil
+	}
+
+	switch cd := cp.CallData().(type) {
+	case daotypes.GovernanceCallData:
+		r[extras.DuplicationKeyTypeContractStatus] = []string{fact.Contract().String()}
+	case daotypes.TransferCallData:
+		r[processor.DuplicationTypeDAOContractTreasury] = []string{cd.Sender().String()}
+	}
+
+	return r, nil
+}
+
 type Execute struct {
 	extras.ExtendedOperation
 }
@@ -2,6 +2,8 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/imfact-labs/currency-model/common"
@@ -83,14 +85,18 @@ func (opp *ExecuteProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
 	}
 
-	if st, err := cstate.ExistsState(state.StateKeyDesign(
-		fact.Contract()), "design", getStateFunc); err != nil {
+	dst, err := cstate.ExistsState(state.StateKeyDesign(
+		fact.Contract()), "design", getStateFunc)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
 				fact.Contract(),
 			)), nil
-	} else if _, err := state.StateDesignValue(st); err != nil {
+	}
+
+	design, err := state.StateDesignValue(dst)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceNF).Errorf("dao service state value for contract account %v",
@@ -98,6 +104,10 @@ func (opp *ExecuteProcessor) PreProcess(
 			)), nil
 	}
 
+	if err := checkExecutor(fact.Contract(), fact.Sender(), design.Policy(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
 	st, err := cstate.ExistsState(
 		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
 	if err != nil {
@@ -130,6 +140,49 @@ func (opp *ExecuteProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
 				Errorf("already executed proposal %q for contract account %v",
 					fact.ProposalID(), fact.Contract())), nil
+	} else if p.Status() == types.Expired {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("expired proposal %q for contract account %v, %s",
+					fact.ProposalID(), fact.Contract(), p.Reason())), nil
+	} else if p.Status() == types.Vetoed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("vetoed proposal %q for contract account %v, %s",
+					fact.ProposalID(), fact.Contract(), p.Reason())), nil
+	}
+
+	// a pending-deposit proposal has no voters to check; it lapses in Process.
+	if p.Status() == types.PendingDeposit {
+		return ctx, nil, nil
+	}
+
+	if p.Status() == types.ExecutionFailed {
+		var attempts int
+
+		switch st, found, err := getStateFunc(state.StateKeyExecutionHistory(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("execution history of proposal %q in contract account %v",
+						fact.ProposalID(), fact.Contract())), nil
+		case found:
+			results, err := state.StateExecutionHistoryValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError(
+					common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+						Errorf("execution history of proposal %q in contract account %v",
+							fact.ProposalID(), fact.Contract())), nil
+			}
+			attempts = len(results)
+		}
+
+		if uint64(attempts) > p.Policy().ExecutionRetries() {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("execution of proposal %q in contract account %v failed %d times and can not be retried, %s",
+						fact.ProposalID(), fact.Contract(), attempts, p.Reason())), nil
+		}
 	}
 
 	if err := cstate.CheckExistsState(state.StateKeyVotingPowerBox(
@@ -141,6 +194,21 @@ func (opp *ExecuteProcessor) PreProcess(
 		), nil
 	}
 
+	// a proposal with a failed dependency is canceled in Process; it keeps its status after the
+	// failure until it is executed.
+	switch pending, failed, err := checkDependencies(fact.Contract(), p.Proposal().Dependencies(), getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("dependency of proposal %q in contract account %v: %v",
+					fact.ProposalID(), fact.Contract(), err)), nil
+	case len(failed) == 0 && len(pending) > 0:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("dependency proposal %q of proposal %q in contract account %v is not executed yet",
+					pending, fact.ProposalID(), fact.Contract())), nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -166,9 +234,52 @@ func (opp *ExecuteProcessor) Process(
 	}
 
 	proposal := *opp.proposal
-	nowTime := uint64(proposal.ProposalFact().ProposedAt().Unix())
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	// a crowdfunded proposal which has not reached the deposit target lapses instead.
+	if sts, err := lapseUnfunded(
+		fact.Contract(), fact.ProposalID(), p, nowTime, opp.Height(), fact.Hash(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to lapse proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	} else if len(sts) > 0 {
+		return sts, nil, nil
+	}
 
 	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Execute, nowTime)
+
+	// the completed proposal is not executed, or its failed execution is not retried
+	// successfully within the execution grace period; it expires now.
+	if period == types.ExecutionExpired && (p.Status() == types.Completed || p.Status() == types.ExecutionFailed) {
+		sts, deposit, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Expired), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(
+			st.Key(),
+			state.NewProposalStateValue(
+				types.Expired,
+				fmt.Sprintf("execution grace period has passed; execution-ended(%d), now(%d)", end, nowTime),
+				types.NewReasonDetail(types.ReasonExecutionExpired),
+				p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+			),
+		))
+
+		hst, err := statusTransitionStateMergeValues(
+			fact.Contract(), fact.ProposalID(), p.Status(), types.Expired, types.ReasonExecutionExpired,
+			opp.Height(), fact.Hash(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		sts = append(sts, hst...)
+
+		return sts, nil, nil
+	}
+
 	if period != types.Execute {
 		return nil, base.NewBaseOperationProcessReasonError(
 			"current time is not within the Execution, Execution period; start(%d), end(%d), but now(%d)",
@@ -178,25 +289,85 @@ func (opp *ExecuteProcessor) Process(
 
 	var sts []base.StateMergeValue
 
-	if p.Status() != types.Completed {
+	if p.Status() != types.Completed && p.Status() != types.ExecutionFailed {
+		dsts, deposit, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Canceled), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, dsts...)
 		sts = append(sts,
 			cstate.NewStateMergeValue(
 				st.Key(),
-				state.NewProposalStateValue(types.Canceled, "execution failed", p.Proposal(), p.Policy()),
+				state.NewProposalStateValue(
+					types.Canceled, "execution failed", types.NewReasonDetail(types.ReasonNotExecutable),
+					p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+				),
 			),
 		)
 
+		hst, err := statusTransitionStateMergeValues(
+			fact.Contract(), fact.ProposalID(), p.Status(), types.Canceled, types.ReasonNotExecutable,
+			opp.Height(), fact.Hash(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		sts = append(sts, hst...)
+
 		return sts, nil, nil
 	}
 
-	sts = append(sts, cstate.NewStateMergeValue(
-		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-		state.NewProposalStateValue(types.Executed, "execution succeeded", p.Proposal(), p.Policy()),
-	))
+	_, failed, err := checkDependencies(fact.Contract(), p.Proposal().Dependencies(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to check dependencies, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	if len(failed) > 0 {
+		dsts, deposit, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Canceled), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, dsts...)
+		sts = append(sts,
+			cstate.NewStateMergeValue(
+				st.Key(),
+				state.NewProposalStateValue(
+					types.Canceled, fmt.Sprintf("dependency proposal %q failed", failed),
+					types.NewReasonDetail(types.ReasonDependencyFailed),
+					p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+				),
+			),
+		)
+
+		hst, err := statusTransitionStateMergeValues(
+			fact.Contract(), fact.ProposalID(), p.Status(), types.Canceled, types.ReasonDependencyFailed,
+			opp.Height(), fact.Hash(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		sts = append(sts, hst...)
+
+		return sts, nil, nil
+	}
+
+	var results []types.ActionResult
 
 	if p.Proposal().Option() == types.ProposalCrypto {
 		cp, _ := p.Proposal().(types.CryptoProposal)
 
+		var asts []base.StateMergeValue
+		var result types.ActionResult
+
 		switch cp.CallData().Type() {
 		case types.CalldataTransfer:
 			cd, ok := cp.CallData().(types.TransferCallData)
@@ -205,64 +376,7 @@ func (opp *ExecuteProcessor) Process(
 					"expected TransferCalldata, not %T", cp.CallData()), nil
 			}
 
-			if err := cstate.CheckExistsState(currency.AccountStateKey(cd.Sender()), getStateFunc); err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
-					"calldata sender not found, %s: %w", cd.Sender(), err), nil
-			}
-
-			if err := cstate.CheckExistsState(currency.AccountStateKey(cd.Receiver()), getStateFunc); err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
-					"calldata receiver not found, %s: %w", cd.Receiver(), err), nil
-			}
-
-			st, err = cstate.ExistsState(
-				currency.BalanceStateKey(cd.Sender(), cd.Amount().Currency()), "key of balance", getStateFunc)
-			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
-					"failed to find calldata sender balance, %s, %q: %w", cd.Sender(), cd.Amount().Currency(), err), nil
-			}
-
-			sb, err := currency.StateBalanceValue(st)
-			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
-					"failed to find calldata sender balance value, %s, %q: %w", cd.Sender(), cd.Amount().Currency(), err), nil
-			}
-
-			if sb.Big().Compare(cd.Amount().Big()) >= 0 {
-				sts = append(sts, cstate.NewStateMergeValue(
-					st.Key(),
-					currency.NewBalanceStateValue(
-						ctypes.NewAmount(sb.Big().Sub(cd.Amount().Big()), cd.Amount().Currency()),
-					),
-				))
-
-				switch st, found, err := getStateFunc(currency.BalanceStateKey(cd.Receiver(), cd.Amount().Currency())); {
-				case err != nil:
-					return nil, base.NewBaseOperationProcessReasonError(
-						"failed to find calldata receiver balance, %s, %q: %w", cd.Receiver(), cd.Amount().Currency(), err), nil
-				case found:
-					rb, err := currency.StateBalanceValue(st)
-					if err != nil {
-						return nil, base.NewBaseOperationProcessReasonError(
-							"failed to find calldata receiver balance value, %s, %q: %w",
-							cd.Receiver(), cd.Amount().Currency(), err), nil
-					}
-
-					sts = append(sts, cstate.NewStateMergeValue(
-						st.Key(),
-						currency.NewBalanceStateValue(
-							ctypes.NewAmount(rb.Big().Add(cd.Amount().Big()), cd.Amount().Currency()),
-						),
-					))
-				default:
-					sts = append(sts, cstate.NewStateMergeValue(
-						st.Key(),
-						currency.NewBalanceStateValue(
-							ctypes.NewAmount(common.ZeroBig.Add(cd.Amount().Big()), cd.Amount().Currency()),
-						),
-					))
-				}
-			}
+			asts, result, err = executeTransfer(cd, getStateFunc)
 		case types.CalldataGovernance:
 			cd, ok := cp.CallData().(types.GovernanceCallData)
 			if !ok {
@@ -270,34 +384,86 @@ func (opp *ExecuteProcessor) Process(
 					"expected GovernanceCalldata, not %T", cp.CallData()), nil
 			}
 
-			st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
-			if err != nil {
+			asts, result, err = executeGovernance(fact.Contract(), cd, opp.Height(), fact.Hash(), getStateFunc)
+		case types.CalldataWhitelist:
+			cd, ok := cp.CallData().(types.WhitelistCallData)
+			if !ok {
 				return nil, base.NewBaseOperationProcessReasonError(
-					common.ErrMServiceNF.Errorf(
-						"dao service state for contract account, %v: %v", fact.Contract(), err)), nil
-			}
-
-			design, err := state.StateDesignValue(st)
-			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(common.ErrMStateInvalid.Errorf(
-					"dao service state value for contract account, %v: %v", fact.Contract(), err)), nil
+					"expected WhitelistCalldata, not %T", cp.CallData()), nil
 			}
 
-			nd := types.NewDesign(design.Option(), cd.Policy())
-
-			if err := nd.IsValid(nil); err != nil {
-				sts = append(sts, cstate.NewStateMergeValue(
-					state.StateKeyDesign(fact.Contract()),
-					state.NewDesignStateValue(
-						nd,
-					),
-				))
-			}
+			asts, result, err = executeWhitelist(fact.Contract(), cd, getStateFunc)
 		default:
 			return nil, base.NewBaseOperationProcessReasonError(
 				"invalid calldata, %s, %q", fact.Contract(), fact.ProposalID()), nil
 		}
+
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to execute calldata, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, asts...)
+		results = append(results, result)
+	}
+
+	var history []types.ExecutionResult
+
+	switch st, found, err := getStateFunc(state.StateKeyExecutionHistory(fact.Contract(), fact.ProposalID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to get execution history, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	case found:
+		if history, err = state.StateExecutionHistoryValue(st); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"execution history value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+	}
+
+	er := types.NewExecutionResult(opp.Height(), results)
+	history = append(history, er)
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyExecutionHistory(fact.Contract(), fact.ProposalID()),
+		state.NewExecutionHistoryStateValue(history),
+	))
+
+	status, code, reason := types.Executed, types.ReasonExecutionSucceeded, "execution succeeded"
+	if !er.Succeeded() {
+		status, code, reason = types.ExecutionFailed, types.ReasonExecutionFailed, fmt.Sprintf(
+			"%s; attempt(%d), max-attempts(%d)",
+			executionFailureReason(er), len(history), p.Policy().ExecutionRetries()+1,
+		)
+	}
+
+	// the deposit of a guarded proposal is still locked; it is settled once the proposal is executed.
+	deposit := p.Deposit()
+	if status == types.Executed {
+		dsts, settled, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(status), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, dsts...)
+		deposit = settled
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+		state.NewProposalStateValue(status, reason, types.NewReasonDetail(code), p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit),
+	))
+
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), fact.ProposalID(), p.Status(), status, code,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
 	}
+	sts = append(sts, hst...)
 
 	return sts, nil, nil
 }
@@ -308,3 +474,148 @@ func (opp *ExecuteProcessor) Close() error {
 
 	return nil
 }
+
+// executeTransfer moves the calldata amount from the sender to the receiver. The locked proposal
+// deposits in the sender balance are not a part of the treasury and can not be transferred.
+// A transfer which can not be applied is reported as a failed action, not as an error.
+func executeTransfer(
+	cd types.TransferCallData, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, types.ActionResult, error) {
+	action := cd.Type()
+
+	if err := cstate.CheckExistsState(currency.AccountStateKey(cd.Sender()), getStateFunc); err != nil {
+		return nil, types.NewActionResult(
+			action, false, fmt.Sprintf("calldata sender %v not found", cd.Sender())), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.AccountStateKey(cd.Receiver()), getStateFunc); err != nil {
+		return nil, types.NewActionResult(
+			action, false, fmt.Sprintf("calldata receiver %v not found", cd.Receiver())), nil
+	}
+
+	sBalanceKey := currency.BalanceStateKey(cd.Sender(), cd.Amount().Currency())
+
+	st, found, err := getStateFunc(sBalanceKey)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	} else if !found {
+		return nil, types.NewActionResult(
+			action, false, fmt.Sprintf("calldata sender %v has no balance of currency id %q",
+				cd.Sender(), cd.Amount().Currency())), nil
+	}
+
+	sb, err := currency.StateBalanceValue(st)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	}
+
+	locked, err := lockedDeposit(cd.Sender(), cd.Amount().Currency(), getStateFunc)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	}
+
+	if sb.Big().Sub(locked).Compare(cd.Amount().Big()) < 0 {
+		return nil, types.NewActionResult(
+			action, false, fmt.Sprintf("not enough balance of calldata sender %v for currency id %q; balance(%v), locked deposit(%v), amount(%v)",
+				cd.Sender(), cd.Amount().Currency(), sb.Big(), locked, cd.Amount().Big())), nil
+	}
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			sBalanceKey,
+			currency.NewDeductBalanceStateValue(cd.Amount()),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, sBalanceKey, cd.Amount().Currency(), st)
+			},
+		),
+		addBalanceStateMergeValue(cd.Receiver(), cd.Amount()),
+	}, types.NewActionResult(action, true, ""), nil
+}
+
+// executeGovernance applies the calldata policy patch to the dao design and bumps the policy version.
+// An invalid new design is reported as a failed action, not as an error. The design is read and
+// written as a whole; ExecuteFact.StateDupKey keeps the other design writes out of the block.
+func executeGovernance(
+	contract base.Address, cd types.GovernanceCallData, height base.Height, factHash util.Hash,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, types.ActionResult, error) {
+	action := cd.Type()
+
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	}
+
+	dv, err := state.StateDesignStateValue(st)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	}
+	design := dv.Design()
+
+	policy := cd.Patch().Apply(design.Policy())
+	if err := design.Bounds().Check(policy); err != nil {
+		return nil, types.NewActionResult(action, false, fmt.Sprintf("policy out of bounds, %v", err)), nil
+	}
+
+	nd := types.NewDesign(design.Option(), policy, design.Bounds())
+	if err := nd.IsValid(nil); err != nil {
+		return nil, types.NewActionResult(action, false, fmt.Sprintf("invalid new design, %v", err)), nil
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(state.StateKeyDesign(contract), state.NewDesignStateValue(
+			nd, dv.AdminRenounced(), dv.PolicyVersion()+1, height, factHash,
+		)),
+	}, types.NewActionResult(action, true, ""), nil
+}
+
+func executeWhitelist(
+	contract base.Address, cd types.WhitelistCallData, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, types.ActionResult, error) {
+	action := cd.Type()
+
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, types.ActionResult{}, err
+	}
+
+	for _, a := range cd.Remove() {
+		if design.Policy().Whitelist().IsExist(a) {
+			return nil, types.NewActionResult(
+				action, false, fmt.Sprintf("account %v is in the policy whitelist", a)), nil
+		}
+	}
+
+	var sts []base.StateMergeValue
+
+	for _, a := range cd.Add() {
+		smv, err := cstate.CreateNotExistAccount(a, getStateFunc)
+		if err != nil {
+			return nil, types.NewActionResult(action, false, err.Error()), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	sts = append(sts, whitelistMemberStateMergeValues(contract, cd.Add(), true)...)
+	sts = append(sts, whitelistMemberStateMergeValues(contract, cd.Remove(), false)...)
+
+	return sts, types.NewActionResult(action, true, ""), nil
+}
+
+func executionFailureReason(er types.ExecutionResult) string {
+	var reasons []string
+
+	for _, a := range er.Actions() {
+		if !a.Success() {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", a.Action(), a.Reason()))
+		}
+	}
+
+	return fmt.Sprintf("execution failed; %s", strings.Join(reasons, ", "))
+}
@@ -0,0 +1,255 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	cprocessor "github.com/imfact-labs/currency-model/operation/processor"
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func TestExecuteLapsesUnfundedProposal(t *testing.T) {
+	d, alice, bob := newTestUnfundedDAO(t)
+	sender := d.newAccount("sender", 100)
+
+	p := NewTestExecuteProcessor(d.tp)
+	p.Create(blockMaps(175)).
+		MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.RunProcess().Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	checkLapsed(t, d, alice, bob)
+}
+
+// checkDuplication checks the operations in order as the operations of one block and returns the
+// error of each.
+func (d *testDAO) checkDuplication(ops ...base.Operation) []error {
+	opr := cprocessor.NewOperationProcessor()
+	opr.GetStateFunc = d.tp.GetStateFunc
+
+	errs := make([]error, len(ops))
+	for i := range ops {
+		errs[i] = processor.CheckDuplication(opr, ops[i])
+	}
+
+	return errs
+}
+
+// execute returns the Execute of the proposal signed by sender.
+func (d *testDAO) execute(sender test.Account, pid string) base.Operation {
+	p := NewTestExecuteProcessor(d.tp)
+	p.MakeOperation(sender.Address(), sender.Priv(), d.contract, pid, d.tp.GenesisCurrency)
+
+	return p.Op
+}
+
+func TestExecuteGovernanceConflictsWithRenounceAdmin(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	sender := d.newAccount("sender", 100)
+
+	d.setGovernanceProposal("1")
+	d.setProposal("2", sender.Address(), types.Completed, types.NewDeposit(sender.Address(), d.amount(0), types.DepositKept))
+
+	rp := NewTestRenounceAdminProcessor(d.tp)
+	rp.MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency)
+
+	if errs := d.checkDuplication(d.execute(sender, "1"), rp.Op); errs[0] != nil {
+		t.Fatal(errs[0])
+	} else if errs[1] == nil {
+		t.Fatal("expected RenounceAdmin rejected in the block of a governance execution")
+	}
+
+	if errs := d.checkDuplication(rp.Op, d.execute(sender, "1")); errs[1] == nil {
+		t.Fatal("expected the governance execution rejected in the block of RenounceAdmin")
+	}
+
+	if errs := d.checkDuplication(d.execute(sender, "2"), rp.Op); errs[0] != nil || errs[1] != nil {
+		t.Fatalf("expected a transfer execution not to conflict with RenounceAdmin, %v", errs)
+	}
+}
+
+func TestExecuteGovernanceConflictsWithDesignWrites(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	sender := d.newAccount("sender", 100)
+
+	d.setGovernanceProposal("1")
+	d.setGovernanceProposal("2")
+
+	if errs := d.checkDuplication(d.execute(sender, "1"), d.execute(sender, "2")); errs[0] != nil {
+		t.Fatal(errs[0])
+	} else if errs[1] == nil {
+		t.Fatal("expected the second governance execution rejected in the same block")
+	}
+
+	votingPeriod := uint64(20)
+
+	up := NewTestUpdatePolicyProcessor(d.tp)
+	up.votingPeriod = &votingPeriod
+	up.MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency)
+
+	if errs := d.checkDuplication(up.Op, d.execute(sender, "1")); errs[0] != nil {
+		t.Fatal(errs[0])
+	} else if errs[1] == nil {
+		t.Fatal("expected the governance execution rejected in the block of UpdateModelConfig")
+	}
+}
+
+func TestExecuteGovernanceIncreasesPolicyVersion(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	sender := d.newAccount("sender", 100)
+
+	d.setGovernanceProposal("1")
+	d.setGovernanceProposal("2")
+
+	version := func() uint64 {
+		st, _, _ := d.tp.GetStateFunc(state.StateKeyDesign(d.contract))
+
+		dv, err := state.StateDesignStateValue(st)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return dv.PolicyVersion()
+	}
+
+	execute := func(pid string) {
+		p := NewTestExecuteProcessor(d.tp)
+		p.Create(blockMaps(165)).
+			MakeOperation(sender.Address(), sender.Priv(), d.contract, pid, d.tp.GenesisCurrency).
+			RunPreProcess()
+		if err := p.Error(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := p.RunProcess().Error(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	updatePolicy := func() {
+		votingPeriod := uint64(20)
+
+		p := NewTestUpdatePolicyProcessor(d.tp)
+		p.votingPeriod = &votingPeriod
+		p.Create().
+			MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency).
+			RunPreProcess()
+		if err := p.Error(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := p.RunProcess().Error(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	last := version()
+	for i, f := range []func(){func() { execute("1") }, updatePolicy, func() { execute("2") }} {
+		f()
+
+		if v := version(); v <= last {
+			t.Fatalf("expected the policy version increased by update %d, %d -> %d", i, last, v)
+		} else {
+			last = v
+		}
+	}
+}
+
+func TestExecuteTransferKeepsLockedDeposits(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	proposer := d.newAccount("proposer", 100)
+	receiver := d.newAccount("receiver", 0)
+
+	d.setBalance(2, 0)
+
+	if err := d.propose(proposer, "1", 50); err != nil {
+		t.Fatal(err)
+	}
+
+	if locked := d.lockedDeposit(t); !locked.Equal(common.NewBig(1)) {
+		t.Fatalf("expected the proposal fee locked, got %v", locked)
+	}
+
+	transfer := func(n int64) bool {
+		sts, result, err := executeTransfer(
+			types.NewTransferCallData(d.contract, receiver.Address(), d.amount(n)), d.tp.GetStateFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		d.merge(t, sts)
+
+		return result.Success()
+	}
+
+	if transfer(3) {
+		t.Fatal("expected the transfer of the locked deposit failed")
+	}
+
+	if !transfer(2) {
+		t.Fatal("expected the transfer of the treasury succeeded")
+	}
+
+	if b := d.balance(t, d.contract); !b.Equal(common.NewBig(1)) {
+		t.Fatalf("expected the locked deposit left in the contract account, got %v", b)
+	}
+}
+
+func TestExecuteGovernanceOutOfBounds(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	sender := d.newAccount("sender", 100)
+
+	d.setPolicy(testPolicy{
+		executors: types.NewExecutors([]base.Address{sender.Address(), d.owner.Address()}),
+	})
+	d.setGovernanceProposal("1")
+
+	d.setState(
+		state.StateKeyDesign(d.contract),
+		state.NewDesignStateValue(
+			types.NewDesign(types.ProposalCrypto, d.policy, types.NewPolicyBounds(
+				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+				0, 0, 0, 0,
+				common.ZeroBig, common.ZeroBig,
+				common.ZeroBig, "",
+				0, 0,
+				0, 0,
+				0, 0, 0, 1,
+			)),
+			false, 1, 0, valuehash.RandomSHA256(),
+		),
+	)
+
+	p := NewTestExecuteProcessor(d.tp)
+	p.Create(blockMaps(165)).
+		MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.RunProcess().Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	st, _, _ := d.tp.GetStateFunc(state.StateKeyDesign(d.contract))
+	dv, err := state.StateDesignStateValue(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dv.PolicyVersion() != 1 {
+		t.Fatal("expected the policy over the executors bound not taken")
+	}
+}
@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+// legacyPolicyBytes returns the bytes of the policy as they were before the policy fields added
+// later.
+func legacyPolicyBytes(po types.Policy) []byte {
+	return util.ConcatBytesSlice(
+		po.VotingPowerToken().Bytes(),
+		po.Threshold().Bytes(),
+		po.ProposalFee().Bytes(),
+		po.Whitelist().Bytes(),
+		util.Uint64ToBytes(po.ProposalReviewPeriod()),
+		util.Uint64ToBytes(po.RegistrationPeriod()),
+		util.Uint64ToBytes(po.PreSnapshotPeriod()),
+		util.Uint64ToBytes(po.VotingPeriod()),
+		util.Uint64ToBytes(po.PostSnapshotPeriod()),
+		util.Uint64ToBytes(po.ExecutionDelayPeriod()),
+		po.Turnout().Bytes(),
+		po.Quorum().Bytes(),
+	)
+}
+
+func checkLegacyHash(t *testing.T, h util.Hash, legacy []byte) {
+	t.Helper()
+
+	if !h.Equal(valuehash.NewSHA256(legacy)) {
+		t.Fatal("expected the fact hash of the legacy layout")
+	}
+}
+
+func TestRegisterModelFactKeepsLegacyHash(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	po := d.policy
+	token := []byte("token")
+
+	fact := NewRegisterModelFact(
+		token, d.owner.Address(), d.contract, types.ProposalCrypto,
+		po.VotingPowerToken(), po.Threshold(), po.ProposalFee(), po.Whitelist(),
+		po.ProposalReviewPeriod(), po.RegistrationPeriod(), po.PreSnapshotPeriod(), po.VotingPeriod(),
+		po.PostSnapshotPeriod(), po.ExecutionDelayPeriod(), po.Turnout(), po.Quorum(),
+		po.DepositRule(), po.DepositPeriod(), po.DepositTarget(), po.Guardians(), po.ObjectionThreshold(),
+		0, 0, 0,
+		types.NewReviewers(nil),
+		false,
+		types.PeriodUnitSecond,
+		0, 0,
+		0, 0,
+		types.NewExecutors(nil),
+		types.NewUnboundedPolicyBounds(),
+		d.tp.GenesisCurrency,
+	)
+
+	checkLegacyHash(t, fact.GenerateHash(), util.ConcatBytesSlice(
+		token,
+		d.owner.Address().Bytes(),
+		d.contract.Bytes(),
+		types.ProposalCrypto.Bytes(),
+		legacyPolicyBytes(po),
+		d.tp.GenesisCurrency.Bytes(),
+	))
+
+	bounded := NewRegisterModelFact(
+		token, d.owner.Address(), d.contract, types.ProposalCrypto,
+		po.VotingPowerToken(), po.Threshold(), po.ProposalFee(), po.Whitelist(),
+		po.ProposalReviewPeriod(), po.RegistrationPeriod(), po.PreSnapshotPeriod(), po.VotingPeriod(),
+		po.PostSnapshotPeriod(), po.ExecutionDelayPeriod(), po.Turnout(), po.Quorum(),
+		po.DepositRule(), po.DepositPeriod(), po.DepositTarget(), po.Guardians(), po.ObjectionThreshold(),
+		0, 0, 0,
+		types.NewReviewers(nil),
+		false,
+		types.PeriodUnitSecond,
+		0, 0,
+		0, 0,
+		types.NewExecutors(nil),
+		types.NewPolicyBounds(
+			1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0,
+			common.ZeroBig, common.ZeroBig,
+			common.ZeroBig, "",
+			0, 0,
+			0, 0,
+			0, 0, 0, 0,
+		),
+		d.tp.GenesisCurrency,
+	)
+
+	if bounded.GenerateHash().Equal(fact.GenerateHash()) {
+		t.Fatal("expected the bounds in the fact hash")
+	}
+}
+
+func TestUpdateModelConfigFactKeepsLegacyHash(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	token := []byte("token")
+
+	fact := NewUpdateModelConfigFact(
+		token, d.owner.Address(), d.contract, types.ProposalCrypto,
+		types.NewPolicyPatchFromPolicy(d.policy), d.tp.GenesisCurrency,
+	)
+
+	checkLegacyHash(t, fact.GenerateHash(), util.ConcatBytesSlice(
+		token,
+		d.owner.Address().Bytes(),
+		d.contract.Bytes(),
+		types.ProposalCrypto.Bytes(),
+		legacyPolicyBytes(d.policy),
+		d.tp.GenesisCurrency.Bytes(),
+	))
+}
+
+func TestProposeFactKeepsLegacyHash(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	token := []byte("token")
+
+	proposal := d.newProposal(d.owner.Address(), 100)
+	fact := NewProposeFact(token, d.owner.Address(), d.contract, "1", proposal, d.tp.GenesisCurrency)
+
+	cp := proposal.(types.CryptoProposal)
+	checkLegacyHash(t, fact.GenerateHash(), util.ConcatBytesSlice(
+		token,
+		d.owner.Address().Bytes(),
+		d.contract.Bytes(),
+		[]byte("1"),
+		util.ConcatBytesSlice(
+			d.owner.Address().Bytes(),
+			util.Uint64ToBytes(100),
+			cp.CallData().Bytes(),
+		),
+		d.tp.GenesisCurrency.Bytes(),
+	))
+
+	optimistic := types.NewCryptoProposal(d.owner.Address(), 100, cp.CallData(), true, nil)
+	if bytes.Equal(optimistic.Bytes(), proposal.Bytes()) {
+		t.Fatal("expected the optimistic flag in the proposal bytes")
+	}
+}
+
+func TestProposalBytesSeparateDependencies(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	cd := types.NewTransferCallData(d.contract, d.owner.Address(), d.amount(1))
+
+	a := types.NewCryptoProposal(d.owner.Address(), 100, cd, false, []string{"1,2"})
+	b := types.NewCryptoProposal(d.owner.Address(), 100, cd, false, []string{"1", "2"})
+
+	if bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Fatal("expected the proposals with different dependencies in different bytes")
+	}
+}
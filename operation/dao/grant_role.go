@@ -0,0 +1,189 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	GrantRoleFactHint = hint.MustNewHint("mitum-dao-grant-role-operation-fact-v0.0.1")
+	GrantRoleHint     = hint.MustNewHint("mitum-dao-grant-role-operation-v0.0.1")
+)
+
+type GrantRoleFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	account  base.Address
+	role     daotypes.Role
+	currency types.CurrencyID
+}
+
+func NewGrantRoleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	account base.Address,
+	role daotypes.Role,
+	currency types.CurrencyID,
+) GrantRoleFact {
+	bf := base.NewBaseFact(GrantRoleFactHint, token)
+	fact := GrantRoleFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		account:  account,
+		role:     role,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GrantRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GrantRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantRoleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.account.Bytes(),
+		fact.role.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact GrantRoleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.role,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract account", fact.account)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact GrantRoleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GrantRoleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GrantRoleFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact GrantRoleFact) Role() daotypes.Role {
+	return fact.role
+}
+
+func (fact GrantRoleFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact GrantRoleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.account
+
+	return as, nil
+}
+
+func (fact GrantRoleFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact GrantRoleFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact GrantRoleFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	// the role key serializes the count of the role holders.
+	r[processor.DuplicationTypeDAOContractRole] = []string{
+		fmt.Sprintf("%s:%s", fact.Contract().String(), fact.Account().String()),
+		fmt.Sprintf("%s:%s", fact.Contract().String(), fact.Role().String()),
+	}
+
+	return r, nil
+}
+
+type GrantRole struct {
+	extras.ExtendedOperation
+}
+
+func (op GrantRole) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewGrantRole(fact GrantRoleFact) GrantRole {
+	return GrantRole{
+		ExtendedOperation: extras.NewExtendedOperation(GrantRoleHint, fact),
+	}
+}
@@ -0,0 +1,184 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var grantRoleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(GrantRoleProcessor)
+	},
+}
+
+func (GrantRole) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type GrantRoleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewGrantRoleProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new GrantRoleProcessor")
+
+		nopp := grantRoleProcessorPool.Get()
+		opp, ok := nopp.(*GrantRoleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected GrantRoleProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *GrantRoleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(GrantRoleFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", GrantRoleFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if err := cstate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	if _, _, _, cErr := cstate.ExistsCAccount(fact.Account(), "account", true, false, getStateFunc); cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: account %v is contract account", cErr, fact.Account())), nil
+	}
+
+	roles, err := rolesOf(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("roles of %v in contract account %v", fact.Account(), fact.Contract())), nil
+	}
+
+	for _, r := range roles {
+		if r == fact.Role() {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v already holds %v role in contract account %v",
+						fact.Account(), fact.Role(), fact.Contract())), nil
+		}
+	}
+
+	if max := fact.Role().MaxHolders(); max > 0 {
+		holders, err := roleHoldersOf(fact.Contract(), fact.Role(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("holders of %v role in contract account %v", fact.Role(), fact.Contract())), nil
+		}
+
+		if holders >= max {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+					Errorf("%v role is held by %d accounts in contract account %v, max %d",
+						fact.Role(), holders, fact.Contract(), max)), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *GrantRoleProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process GrantRole")
+
+	fact, ok := op.Fact().(GrantRoleFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected GrantRoleFact, not %T", op.Fact())
+	}
+
+	roles, err := rolesOf(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("roles of %v not found; %w", fact.Account(), err), nil
+	}
+
+	holders, err := roleHoldersOf(fact.Contract(), fact.Role(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("holders of %v role not found; %w", fact.Role(), err), nil
+	}
+
+	var sts []base.StateMergeValue
+
+	smv, err := cstate.CreateNotExistAccount(fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	sts = append(sts,
+		cstate.NewStateMergeValue(
+			state.StateKeyRoles(fact.Contract(), fact.Account()),
+			state.NewRolesStateValue(fact.Account(), append(roles, fact.Role())),
+		),
+		cstate.NewStateMergeValue(
+			state.StateKeyRoleHolders(fact.Contract(), fact.Role()),
+			state.NewRoleHoldersStateValue(fact.Role(), holders+1),
+		),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *GrantRoleProcessor) Close() error {
+	grantRoleProcessorPool.Put(opp)
+
+	return nil
+}
@@ -84,13 +84,17 @@ func (opp *PostSnapProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
 	}
 
-	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+	dst, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
 				fact.Contract(),
 			)), nil
-	} else if _, err := state.StateDesignValue(st); err != nil {
+	}
+
+	design, err := state.StateDesignValue(dst)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
@@ -98,6 +102,10 @@ func (opp *PostSnapProcessor) PreProcess(
 			)), nil
 	}
 
+	if err := checkExecutor(fact.Contract(), fact.Sender(), design.Policy(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
 	st, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
@@ -126,10 +134,17 @@ func (opp *PostSnapProcessor) PreProcess(
 				Errorf("already post snapped proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
 	}
 
-	if err := cstate.CheckExistsState(state.StateKeyVoters(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
-				Errorf("voters for proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	// a pending-deposit proposal has no voters to check; it lapses in Process.
+	if p.Status() == types.PendingDeposit {
+		return ctx, nil, nil
+	}
+
+	if !types.IsOptimistic(p.Proposal()) {
+		if err := cstate.CheckExistsState(state.StateKeyVoters(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("voters for proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+		}
 	}
 
 	if err := cstate.CheckExistsState(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
@@ -158,7 +173,16 @@ func (opp *PostSnapProcessor) Process(
 	}
 
 	proposal := *opp.proposal
-	nowTime := uint64(proposal.ProposalFact().ProposedAt().Unix())
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	// a crowdfunded proposal which has not reached the deposit target lapses instead.
+	if sts, err := lapseUnfunded(
+		fact.Contract(), fact.ProposalID(), p, nowTime, opp.Height(), fact.Hash(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to lapse proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	} else if len(sts) > 0 {
+		return sts, nil, nil
+	}
 
 	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.PostSnapshot, nowTime)
 	if period != types.PostSnapshot {
@@ -168,13 +192,35 @@ func (opp *PostSnapProcessor) Process(
 	var sts []base.StateMergeValue
 
 	if p.Status() != types.PreSnapped {
+		dsts, deposit, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Canceled), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, dsts...)
 		sts = append(sts,
 			cstate.NewStateMergeValue(
 				st.Key(),
-				state.NewProposalStateValue(types.Canceled, "post-snap failed as the pre-snap was not executed", p.Proposal(), p.Policy()),
+				state.NewProposalStateValue(
+					types.Canceled, "post-snap failed as the pre-snap was not executed",
+					types.NewReasonDetail(types.ReasonPreSnapMissed),
+					p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+				),
 			),
 		)
 
+		hst, err := statusTransitionStateMergeValues(
+			fact.Contract(), fact.ProposalID(), p.Status(), types.Canceled, types.ReasonPreSnapMissed,
+			opp.Height(), fact.Hash(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		sts = append(sts, hst...)
+
 		return sts, nil, nil
 	}
 
@@ -281,13 +327,36 @@ func (opp *PostSnapProcessor) Process(
 	actualQuorumCount := p.Policy().Quorum().Quorum(votedTotal)
 
 	r := types.Rejected
+	code := types.ReasonNotApproved
+	winner := types.NoWinningOption
+	turnout, turnoutCount := p.Policy().Turnout(), actualTurnoutCount
+	objectionCount := common.ZeroBig
 	var reason string
 
 	switch {
+	case types.IsOptimistic(p.Proposal()):
+		// the turnout is waived, not judged; it is recorded neither in the tally nor in the reason.
+		turnout, turnoutCount = 0, common.ZeroBig
+
+		// optimistic proposal passes unless disapproval votes exceed the objection threshold of total supply.
+		objectionCount = p.Policy().ObjectionThreshold().Quorum(currencyDesign.TotalSupply())
+		against, found := votingResult[1]
+		if !found {
+			against = common.ZeroBig
+		}
+
+		if 0 < against.Compare(objectionCount) {
+			r, code = types.Rejected, types.ReasonObjectionExceeded
+			reason = fmt.Sprintf("disapproval votes, %v exceed the objection threshold, %v for optimistic proposal", against, objectionCount)
+		} else {
+			r, code, winner = types.Completed, types.ReasonObjectionNotExceeded, 0
+			reason = fmt.Sprintf("disapproval votes, %v do not exceed the objection threshold, %v for optimistic proposal", against, objectionCount)
+		}
 	case votedTotal.Compare(actualTurnoutCount) < 0:
-		r = types.Canceled
+		r, code = types.Canceled, types.ReasonTurnoutNotReached
 		reason = fmt.Sprintf("total votes, %v is less than turnout, %v", votedTotal, actualTurnoutCount)
 	case nvpb.Total().Compare(actualQuorumCount) < 0:
+		code = types.ReasonQuorumNotReached
 		reason = fmt.Sprintf("registerd total voting power, %v is less than quorum, %v", nvpb.Total(), actualQuorumCount)
 	case p.Proposal().Option() == types.ProposalCrypto:
 		vr0, found0 := votingResult[0]
@@ -299,13 +368,13 @@ func (opp *PostSnapProcessor) Process(
 		} else {
 			if found1 {
 				if (0 < vr0.Compare(actualQuorumCount)) && (0 < vr0.Compare(vr1)) {
-					r = types.Completed
+					r, code, winner = types.Completed, types.ReasonApproved, 0
 					reason = fmt.Sprintf("approve votes, %v is greater than disapproval votes, %v and exceed the quorum, %v for crypto proposal", vr0, vr1, actualQuorumCount)
 					break
 				}
 			} else {
 				if 0 < vr0.Compare(actualQuorumCount) {
-					r = types.Completed
+					r, code, winner = types.Completed, types.ReasonApproved, 0
 					reason = fmt.Sprintf("no disapproval votes and approve votes, %v exceed the quorum,n %v for crypto proposal", vr0, actualQuorumCount)
 					break
 				}
@@ -333,16 +402,68 @@ func (opp *PostSnapProcessor) Process(
 		}
 
 		if count == 1 {
-			r = types.Completed
+			r, code, winner = types.Completed, types.ReasonApproved, mvpOption
 			reason = fmt.Sprintf("voting option %v is greater than any other option and voting result, %v exceed the quorum, %v", mvpOption, mvp, actualQuorumCount)
 		}
 	}
 
+	// the deposit of a completed proposal which the guardians can veto stays locked
+	// until the proposal is executed, vetoed or expired.
+	deposit := p.Deposit()
+	if r != types.Completed || !p.Policy().Guardians().Guards(p.Proposal()) {
+		dsts, settled, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(r), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, dsts...)
+		deposit = settled
+	}
+
+	result := types.SortVotingResult(votingResult, p.Proposal().VoteOptionsCount())
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyTally(fact.Contract(), fact.ProposalID()),
+		state.NewTallyStateValue(types.NewTally(
+			currencyDesign.TotalSupply(),
+			turnout,
+			turnoutCount,
+			votedTotal,
+			nvpb.Total(),
+			p.Policy().Quorum(),
+			actualQuorumCount,
+			p.Policy().ObjectionThreshold(),
+			objectionCount,
+			result,
+			r,
+		)),
+	))
+
+	detail := types.NewReasonDetail(code).WithTally(winner, result)
+	if !types.IsOptimistic(p.Proposal()) {
+		detail = detail.WithTurnout(votedTotal, turnoutCount)
+	}
+
 	sts = append(sts, cstate.NewStateMergeValue(
 		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-		state.NewProposalStateValue(r, reason, p.Proposal(), p.Policy()),
+		state.NewProposalStateValue(
+			r, reason, detail,
+			p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+		),
 	))
 
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), fact.ProposalID(), p.Status(), r, code,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+	sts = append(sts, hst...)
+
 	return sts, nil, nil
 }
 
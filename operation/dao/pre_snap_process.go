@@ -87,15 +87,19 @@ func (opp *PreSnapProcessor) PreProcess(
 		), nil
 	}
 
-	if st, err := cstate.ExistsState(state.StateKeyDesign(
-		fact.Contract()), "design", getStateFunc); err != nil {
+	dst, err := cstate.ExistsState(state.StateKeyDesign(
+		fact.Contract()), "design", getStateFunc)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account, %v",
 				fact.Contract(),
 			),
 		), nil
-	} else if _, err := state.StateDesignValue(st); err != nil {
+	}
+
+	design, err := state.StateDesignValue(dst)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract aqccount, %v",
@@ -104,6 +108,10 @@ func (opp *PreSnapProcessor) PreProcess(
 		), nil
 	}
 
+	if err := checkExecutor(fact.Contract(), fact.Sender(), design.Policy(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
 	st, err := cstate.ExistsState(
 		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
 	if err != nil {
@@ -133,24 +141,51 @@ func (opp *PreSnapProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
 				Errorf("already preSnapped, %s, %q", fact.Contract(), fact.ProposalID()),
 		), nil
+	} else if p.Status() == types.Rejected {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("already rejected proposal, %s, %q", fact.Contract(), fact.ProposalID()),
+		), nil
+	} else if p.Status() == types.Lapsed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("already lapsed proposal, %s, %q", fact.Contract(), fact.ProposalID()),
+		), nil
 	}
 
-	if err := cstate.CheckExistsState(
-		state.StateKeyVoters(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
-				Errorf("voters, %s, %v: %v", fact.Contract(), fact.ProposalID(), err),
-		), nil
+	// a pending-deposit proposal has no voters to check; it lapses in Process.
+	if p.Status() == types.PendingDeposit {
+		return ctx, nil, nil
 	}
 
-	if err := cstate.CheckExistsState(
-		state.StateKeyDelegators(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
+	unsponsored, err := isUnsponsored(fact.Contract(), fact.ProposalID(), p, getStateFunc)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
-				Errorf("delegators, %s, %v: %v", fact.Contract(), fact.ProposalID(), err),
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("sponsors, %s, %v: %v", fact.Contract(), fact.ProposalID(), err),
 		), nil
 	}
 
+	// an optimistic proposal passes without any registered voter, and an unsponsored
+	// proposal lapses without them.
+	if !types.IsOptimistic(p.Proposal()) && !unsponsored {
+		if err := cstate.CheckExistsState(
+			state.StateKeyVoters(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("voters, %s, %v: %v", fact.Contract(), fact.ProposalID(), err),
+			), nil
+		}
+
+		if err := cstate.CheckExistsState(
+			state.StateKeyDelegators(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("delegators, %s, %v: %v", fact.Contract(), fact.ProposalID(), err),
+			), nil
+		}
+	}
+
 	if found, err := cstate.CheckNotExistsState(
 		state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()), getStateFunc); found {
 		return nil, base.NewBaseOperationProcessReasonError(
@@ -183,7 +218,16 @@ func (opp *PreSnapProcessor) Process(
 	}
 
 	proposal := *opp.proposal
-	nowTime := uint64(proposal.ProposalFact().ProposedAt().Unix())
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	// a crowdfunded proposal which has not reached the deposit target lapses instead.
+	if sts, err := lapseUnfunded(
+		fact.Contract(), fact.ProposalID(), p, nowTime, opp.Height(), fact.Hash(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to lapse proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	} else if len(sts) > 0 {
+		return sts, nil, nil
+	}
 
 	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.PreSnapshot, nowTime)
 	if period != types.PreSnapshot {
@@ -193,6 +237,15 @@ func (opp *PreSnapProcessor) Process(
 		), nil
 	}
 
+	// a proposal which has not collected enough sponsors during the review period lapses.
+	if sts, err := lapseUnsponsored(
+		fact.Contract(), fact.ProposalID(), p, opp.Height(), fact.Hash(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to check sponsorship of proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	} else if len(sts) > 0 {
+		return sts, nil, nil
+	}
+
 	var sts []base.StateMergeValue
 
 	var votingPowerBox types.VotingPowerBox
@@ -276,18 +329,58 @@ func (opp *PreSnapProcessor) Process(
 	}
 
 	actualTurnoutCount := p.Policy().Turnout().Quorum(currencyDesign.TotalSupply())
-	if votingPowerBox.Total().Compare(actualTurnoutCount) < 0 {
+
+	var to types.ProposalStatus
+	var code types.ReasonCode
+
+	if types.IsOptimistic(p.Proposal()) {
+		to, code = types.PreSnapped, types.ReasonTurnoutWaived
+
+		sts = append(sts,
+			cstate.NewStateMergeValue(
+				state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+				state.NewProposalStateValue(
+					types.PreSnapped, "turnout is waived for optimistic proposal",
+					types.NewReasonDetail(code),
+					p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), p.Deposit(),
+				),
+			),
+			cstate.NewStateMergeValue(
+				state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
+				state.NewVotingPowerBoxStateValue(votingPowerBox),
+			),
+		)
+	} else if votingPowerBox.Total().Compare(actualTurnoutCount) < 0 {
+		to, code = types.Canceled, types.ReasonTurnoutNotReached
 		reason := fmt.Sprintf("total voting power, %v is less than turnout, %v", votingPowerBox.Total(), actualTurnoutCount)
+
+		dsts, deposit, err := settleDeposit(
+			fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Canceled), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, dsts...)
 		sts = append(sts, cstate.NewStateMergeValue(
 			state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-			state.NewProposalStateValue(types.Canceled, reason, p.Proposal(), p.Policy()),
+			state.NewProposalStateValue(
+				types.Canceled, reason,
+				types.NewReasonDetail(code).WithTurnout(votingPowerBox.Total(), actualTurnoutCount),
+				p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+			),
 		))
 	} else {
+		to, code = types.PreSnapped, types.ReasonTurnoutReached
 		reason := fmt.Sprintf("total voting power, %v is greater than turnout, %v", votingPowerBox.Total(), actualTurnoutCount)
 		sts = append(sts,
 			cstate.NewStateMergeValue(
 				state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-				state.NewProposalStateValue(types.PreSnapped, reason, p.Proposal(), p.Policy()),
+				state.NewProposalStateValue(
+					types.PreSnapped, reason,
+					types.NewReasonDetail(code).WithTurnout(votingPowerBox.Total(), actualTurnoutCount),
+					p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), p.Deposit(),
+				),
 			),
 			cstate.NewStateMergeValue(
 				state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
@@ -296,6 +389,16 @@ func (opp *PreSnapProcessor) Process(
 		)
 	}
 
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), fact.ProposalID(), p.Status(), to, code,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+	sts = append(sts, hst...)
+
 	return sts, nil, nil
 }
 
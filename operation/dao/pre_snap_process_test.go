@@ -0,0 +1,101 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+)
+
+// newTestUnfundedDAO returns a dao with the pending deposit proposal "1" which has collected 5 of
+// the deposit target 10 from alice and bob. Its deposit period ends at 110.
+func newTestUnfundedDAO(t *testing.T) (*testDAO, test.Account, test.Account) {
+	t.Helper()
+
+	d := newTestDAO(t, testPolicy{
+		depositRule:   types.NewDepositRule(types.DepositBurn, types.DepositBurn, types.DepositBurn, types.DepositBurn),
+		depositPeriod: 10,
+		depositTarget: common.NewBig(10),
+	})
+
+	proposer := d.newAccount("proposer", 0)
+	alice := d.newAccount("alice", 0)
+	bob := d.newAccount("bob", 0)
+
+	d.setProposal("1", proposer.Address(), types.PendingDeposit,
+		types.NewDeposit(proposer.Address(), d.amount(5), types.DepositLocked))
+	d.setState(
+		state.StateKeyDepositContributions(d.contract, "1"),
+		state.NewDepositContributionsStateValue([]types.DepositContribution{
+			types.NewDepositContribution(alice.Address(), d.amount(3)),
+			types.NewDepositContribution(bob.Address(), d.amount(2)),
+		}),
+	)
+	d.setBalance(0, 5)
+
+	return d, alice, bob
+}
+
+// checkLapsed checks the proposal "1" of newTestUnfundedDAO lapsed with the refund of the
+// contributions, whatever the deposit rule is.
+func checkLapsed(t *testing.T, d *testDAO, alice, bob test.Account) {
+	t.Helper()
+
+	pv := d.proposal(t, "1")
+	if pv.Status() != types.Lapsed {
+		t.Fatalf("expected lapsed proposal, got %v", pv.Status())
+	}
+
+	if pv.Deposit().IsLocked() {
+		t.Fatal("expected the deposit settled")
+	}
+
+	if b := d.balance(t, alice.Address()); !b.Equal(common.NewBig(3)) {
+		t.Fatalf("expected 3 refunded to alice, got %v", b)
+	}
+
+	if b := d.balance(t, bob.Address()); !b.Equal(common.NewBig(2)) {
+		t.Fatalf("expected 2 refunded to bob, got %v", b)
+	}
+
+	if b := d.balance(t, d.contract); !b.IsZero() {
+		t.Fatalf("expected nothing left in the contract account, got %v", b)
+	}
+}
+
+func TestPreSnapLapsesUnfundedProposal(t *testing.T) {
+	d, alice, bob := newTestUnfundedDAO(t)
+	sender := d.newAccount("sender", 100)
+
+	p := NewTestPreSnapProcessor(d.tp)
+	p.Create(blockMaps(105)).
+		MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.RunProcess().Error() == nil {
+		t.Fatal("expected the proposal not pre-snapped in the deposit period")
+	}
+
+	if pv := d.proposal(t, "1"); pv.Status() != types.PendingDeposit {
+		t.Fatalf("expected pending deposit proposal, got %v", pv.Status())
+	}
+
+	p = NewTestPreSnapProcessor(d.tp)
+	p.Create(blockMaps(135)).
+		MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.RunProcess().Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	checkLapsed(t, d, alice, bob)
+}
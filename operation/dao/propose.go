@@ -7,6 +7,7 @@ import (
 	"github.com/imfact-labs/currency-model/operation/extras"
 	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/dao-model/state"
 	"github.com/imfact-labs/dao-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -95,16 +96,19 @@ func (fact ProposeFact) IsValid(b []byte) error {
 			common.ErrValueInvalid.Wrap(errors.Errorf("sender %v is not same with the proposer of proposal", fact.sender)))
 	}
 
-	if len(fact.proposalID) == 0 {
-		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("empty proposal ID")))
-	}
-
 	if !ctypes.ReValidSpcecialCh.Match([]byte(fact.proposalID)) {
 		return common.ErrFactInvalid.Wrap(
 			common.ErrValueInvalid.Wrap(common.ErrValueInvalid.Wrap(
 				errors.Errorf("proposal ID %v must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.proposalID))))
 	}
 
+	for _, d := range fact.proposal.Dependencies() {
+		if d == fact.proposalID {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("proposal %q depends on itself", fact.proposalID)))
+		}
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -167,11 +171,54 @@ func (fact ProposeFact) ActiveContract() []base.Address {
 
 func (fact ProposeFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
 	r := make(map[ctypes.DuplicationKeyType][]string)
+
+	if len(fact.ProposalID()) == 0 {
+		return r, nil
+	}
+
 	r[processor.DuplicationTypeDAOContractProposal] = []string{fmt.Sprintf("%s:%s", fact.Contract().String(), fact.ProposalID())}
 
 	return r, nil
 }
 
+// StateDupKey takes the id the proposal is assigned for a proposal without an id, so it collides
+// with a proposal with the same id in the block. With the active proposal caps, it also takes the
+// proposal counter of the dao, or of the proposer for the proposer cap alone, because the caps
+// count the proposals before the block.
+func (fact ProposeFact) StateDupKey(getStateFunc base.GetStateFunc) (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+
+	if len(fact.ProposalID()) == 0 {
+		pid, _, err := nextProposalID(fact.Contract(), getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		r[processor.DuplicationTypeDAOContractProposal] = []string{fmt.Sprintf("%s:%s", fact.Contract().String(), pid)}
+	}
+
+	st, found, err := getStateFunc(state.StateKeyDesign(fact.Contract()))
+	if err != nil || !found {
+		return r, err
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return r, nil
+	}
+
+	switch policy := design.Policy(); {
+	case 0 < policy.MaxActiveProposals():
+		r[processor.DuplicationTypeDAOContractProposalCounter] = []string{fact.Contract().String()}
+	case 0 < policy.MaxProposerProposals():
+		r[processor.DuplicationTypeDAOContractProposalCounter] = []string{
+			fmt.Sprintf("%s:%s", fact.Contract().String(), fact.Sender().String()),
+		}
+	}
+
+	return r, nil
+}
+
 type Propose struct {
 	extras.ExtendedOperation
 }
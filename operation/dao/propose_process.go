@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"strconv"
 	"sync"
 
 	"github.com/imfact-labs/currency-model/common"
@@ -29,11 +30,13 @@ func (Propose) Process(
 
 type ProposeProcessor struct {
 	*base.BaseOperationProcessor
+	proposal *base.ProposalSignFact
 }
 
-func NewProposeProcessor() ctypes.GetNewProcessor {
+func NewProposeProcessor() ctypes.GetNewProcessorWithProposal {
 	return func(
 		height base.Height,
+		proposal *base.ProposalSignFact,
 		getStateFunc base.GetStateFunc,
 		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
 		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
@@ -53,6 +56,7 @@ func NewProposeProcessor() ctypes.GetNewProcessor {
 		}
 
 		opp.BaseOperationProcessor = b
+		opp.proposal = proposal
 
 		return opp, nil
 	}
@@ -75,10 +79,12 @@ func (opp *ProposeProcessor) PreProcess(
 				Errorf("%v", err)), nil
 	}
 
-	if found, _ := cstate.CheckNotExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), getStateFunc); found {
-		return nil, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMStateE).
-				Errorf("proposal %q already exists in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	if len(fact.ProposalID()) > 0 {
+		if found, _ := cstate.CheckNotExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), getStateFunc); found {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateE).
+					Errorf("proposal %q already exists in contract account %v", fact.ProposalID(), fact.Contract())), nil
+		}
 	}
 
 	required := map[string]common.Big{}
@@ -88,7 +94,7 @@ func (opp *ProposeProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
 	}
-	
+
 	fee := currencyPolicy.Feeer().Fee()
 
 	required[fact.currency.String()] = fee
@@ -113,11 +119,89 @@ func (opp *ProposeProcessor) PreProcess(
 				Errorf("dao option != proposal option, dao(%s) != proposal(%s)", design.Option(), fact.Proposal().Option())), nil
 	}
 
+	if types.IsOptimistic(fact.Proposal()) && design.Policy().ObjectionThreshold() == 0 {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("optimistic proposal is not allowed without objection threshold in contract account %v", fact.Contract())), nil
+	}
+
+	// turnout is waived only for the routine treasury payouts.
+	if cp, ok := fact.Proposal().(types.CryptoProposal); ok && cp.Optimistic() && cp.CallData().Type() != types.CalldataTransfer {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("optimistic proposal is allowed only for %s calldata, not %s", types.CalldataTransfer, cp.CallData().Type())), nil
+	}
+
+	// the start time is checked against the block proposal time, so a proposal can not
+	// start in the past or hold its fee for too long before it starts. Like the max lead time,
+	// the min lead time is not checked when it is zero, so the daos without it accept any start
+	// time as before.
+	if opp.proposal != nil {
+		policy := design.Policy()
+		proposal := *opp.proposal
+		nowTime := policy.PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+		startTime := fact.Proposal().StartTime()
+
+		if 0 < policy.MinLeadTime() && startTime < nowTime+policy.MinLeadTime() {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+					Errorf("proposal start time %d is earlier than now(%d) + min lead time(%d)",
+						startTime, nowTime, policy.MinLeadTime())), nil
+		}
+
+		if 0 < policy.MaxLeadTime() && nowTime+policy.MaxLeadTime() < startTime {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+					Errorf("proposal start time %d is later than now(%d) + max lead time(%d)",
+						startTime, nowTime, policy.MaxLeadTime())), nil
+		}
+	}
+
+	// only one proposal is proposed to a dao in a block, so the proposals before the block are all
+	// the proposals to count.
+	if policy := design.Policy(); 0 < policy.MaxActiveProposals() || 0 < policy.MaxProposerProposals() {
+		nowTime := uint64(0)
+		if opp.proposal != nil {
+			nowTime = policy.PeriodNow((*opp.proposal).ProposalFact().ProposedAt(), opp.Height())
+		}
+
+		total, n, err := countActiveProposals(fact.Contract(), fact.Sender(), opp.proposal != nil, nowTime, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("active proposals of contract account %v, %v", fact.Contract(), err)), nil
+		}
+
+		if 0 < policy.MaxActiveProposals() && policy.MaxActiveProposals() <= total {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+					Errorf("contract account %v already has %d active proposals, max(%d)",
+						fact.Contract(), total, policy.MaxActiveProposals())), nil
+		}
+
+		if 0 < policy.MaxProposerProposals() && policy.MaxProposerProposals() <= n {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+					Errorf("proposer %v already has %d active proposals in contract account %v, max(%d)",
+						fact.Sender(), n, fact.Contract(), policy.MaxProposerProposals())), nil
+		}
+	}
+
+	for _, d := range fact.Proposal().Dependencies() {
+		if _, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), d), "dependency proposal", getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("dependency proposal %q in contract account %v", d, fact.Contract())), nil
+		}
+	}
+
 	votingPowerToken := design.Policy().VotingPowerToken()
 	threshold := design.Policy().Threshold()
 	proposeFee := design.Policy().ProposalFee()
 	whitelist := design.Policy().Whitelist()
 
+	// the proposer of a crowdfunded proposal still needs the threshold and the proposal fee, though
+	// the deposit is gathered from any account during the deposit period.
 	if _, found := required[votingPowerToken.String()]; !found {
 		required[votingPowerToken.String()] = common.ZeroBig
 	}
@@ -149,10 +233,21 @@ func (opp *ProposeProcessor) PreProcess(
 		}
 	}
 
-	if whitelist.Active() && !whitelist.IsExist(fact.Sender()) {
-		return nil, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
-				Errorf("sender not in whitelist, %s", fact.Sender())), nil
+	if whitelist.Active() {
+		whitelisted, err := isWhitelisted(fact.Contract(), whitelist, fact.Sender(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("whitelist member %v in contract account %v", fact.Sender(), fact.Contract())), nil
+		}
+
+		// an account out of the whitelist may still propose when the proposal can be sponsored by
+		// whitelisted accounts during the review period.
+		if !whitelisted && design.Policy().SponsorsRequired() < 1 {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+					Errorf("sender not in whitelist, %s", fact.Sender())), nil
+		}
 	}
 
 	return ctx, nil, nil
@@ -171,20 +266,77 @@ func (opp *ProposeProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("dao not found, %s: %w", fact.Contract(), err), nil
 	}
 
-	design, err := state.StateDesignValue(st)
+	dv, err := state.StateDesignStateValue(st)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("dao value not found, %s: %w", fact.Contract(), err), nil
 	}
+	design := dv.Design()
 
 	proposeFee := design.Policy().ProposalFee()
 
+	pid := fact.ProposalID()
+	var sequence uint64
+
+	if len(pid) == 0 {
+		pid, sequence, err = nextProposalID(fact.Contract(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to assign proposal id, %s: %w", fact.Contract(), err), nil
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(
+			state.StateKeyProposalCounter(fact.Contract()),
+			state.NewProposalCounterStateValue(sequence),
+		))
+	}
+
+	if design.Policy().DepositPeriod() > 0 {
+		sts = append(sts,
+			cstate.NewStateMergeValue(
+				state.StateKeyProposal(fact.Contract(), pid),
+				state.NewProposalStateValue(
+					types.PendingDeposit, "waiting for deposit", types.NewReasonDetail(types.ReasonDepositPending),
+					fact.Proposal(), sequence, nil, design.Policy(), dv.PolicyVersion(),
+					types.NewDeposit(
+						fact.Sender(), ctypes.NewAmount(common.ZeroBig, proposeFee.Currency()), types.DepositLocked),
+				),
+			),
+		)
+
+		hst, err := statusTransitionStateMergeValues(
+			fact.Contract(), pid, types.NilStatus, types.PendingDeposit, types.ReasonDepositPending,
+			opp.Height(), fact.Hash(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to record status transition, %s, %q: %w", fact.Contract(), pid, err), nil
+		}
+		sts = append(sts, hst...)
+
+		return sts, nil, nil
+	}
+
 	sts = append(sts,
 		cstate.NewStateMergeValue(
-			state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-			state.NewProposalStateValue(types.Proposed, "proposed", fact.Proposal(), design.Policy()),
+			state.StateKeyProposal(fact.Contract(), pid),
+			state.NewProposalStateValue(
+				types.Proposed, "proposed", types.NewReasonDetail(types.ReasonProposed),
+				fact.Proposal(), sequence, nil, design.Policy(), dv.PolicyVersion(),
+				types.NewDeposit(fact.Sender(), proposeFee, types.DepositLocked),
+			),
 		),
 	)
 
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), pid, types.NilStatus, types.Proposed, types.ReasonProposed,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), pid, err), nil
+	}
+	sts = append(sts, hst...)
+
 	st, err = cstate.ExistsState(currency.BalanceStateKey(fact.Sender(), proposeFee.Currency()), "key of sender balance", getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("sender balance for propose fee not found, %s, %q: %w", fact.Sender(), proposeFee.Currency(), err), nil
@@ -212,13 +364,97 @@ func (opp *ProposeProcessor) Process(
 			func(height base.Height, st base.State) base.StateValueMerger {
 				return currency.NewBalanceStateValueMerger(height, cBalanceKey, proposeFee.Currency(), st)
 			},
-		))
+		),
+		lockedDepositStateMergeValue(fact.Contract(), state.NewLockDepositStateValue(proposeFee), proposeFee.Currency()),
+	)
 
 	return sts, nil, nil
 }
 
 func (opp *ProposeProcessor) Close() error {
+	opp.proposal = nil
 	proposeProcessorPool.Put(opp)
 
 	return nil
 }
+
+// nextProposalID returns the next sequence number of the dao and the proposal id
+// made from it, skipping the numbers already taken by proposals proposed with an id.
+func nextProposalID(contract base.Address, getStateFunc base.GetStateFunc) (string, uint64, error) {
+	var sequence uint64
+
+	switch st, found, err := getStateFunc(state.StateKeyProposalCounter(contract)); {
+	case err != nil:
+		return "", 0, err
+	case found:
+		if sequence, err = state.StateProposalCounterValue(st); err != nil {
+			return "", 0, err
+		}
+	}
+
+	for {
+		sequence++
+		pid := strconv.FormatUint(sequence, 10)
+
+		switch _, found, err := getStateFunc(state.StateKeyProposal(contract, pid)); {
+		case err != nil:
+			return "", 0, err
+		case !found:
+			return pid, sequence, nil
+		}
+	}
+}
+
+// activeProposalIDs returns the ids of the proposals of the dao which have not ended yet.
+func activeProposalIDs(contract base.Address, getStateFunc base.GetStateFunc) ([]string, error) {
+	switch st, found, err := getStateFunc(state.StateKeyActiveProposals(contract)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		return state.StateActiveProposalsValue(st)
+	}
+}
+
+// countActiveProposals returns the number of the active proposals of the dao and the number of them
+// proposed by the proposer. With timed, the stale proposals at nowTime are not counted.
+func countActiveProposals(
+	contract, proposer base.Address, timed bool, nowTime uint64, getStateFunc base.GetStateFunc,
+) (uint64, uint64, error) {
+	pids, err := activeProposalIDs(contract, getStateFunc)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var total, n uint64
+
+	for _, pid := range pids {
+		st, err := cstate.ExistsState(state.StateKeyProposal(contract, pid), "active proposal", getStateFunc)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		p, err := state.StateProposalValue(st)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if timed {
+			switch stale, err := isStaleProposal(contract, pid, p, nowTime, getStateFunc); {
+			case err != nil:
+				return 0, 0, err
+			case stale:
+				continue
+			}
+		}
+
+		total++
+
+		if p.Proposal().Proposer().Equal(proposer) {
+			n++
+		}
+	}
+
+	return total, n, nil
+}
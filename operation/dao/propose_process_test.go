@@ -0,0 +1,172 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func (d *testDAO) propose(proposer test.Account, pid string, now int64) error {
+	return d.proposeProposal(proposer, pid, d.newProposal(proposer.Address(), 200), now)
+}
+
+func (d *testDAO) proposeProposal(proposer test.Account, pid string, proposal types.Proposal, now int64) error {
+	p := NewTestProposeProcessor(d.tp)
+	p.Create(blockMaps(now)).
+		MakeOperation(proposer.Address(), proposer.Priv(), d.contract, pid, proposal, d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := p.Error(); err != nil {
+		return err
+	}
+
+	return p.RunProcess().Error()
+}
+
+func TestProposeAssignsNextProposalID(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	proposer := d.newAccount("proposer", 100)
+
+	if err := d.propose(proposer, "1", 50); err != nil {
+		t.Fatal(err)
+	}
+
+	// the proposal without an id skips the number taken by the proposal with the id.
+	for _, pid := range []string{"2", "3"} {
+		if err := d.propose(proposer, "", 50); err != nil {
+			t.Fatal(err)
+		}
+
+		if pv := d.proposal(t, pid); pv.Sequence() == 0 || pv.Status() != types.Proposed {
+			t.Fatalf("expected proposal %q proposed with the sequence, got %v, %d", pid, pv.Status(), pv.Sequence())
+		}
+	}
+
+	st, _, _ := d.tp.GetStateFunc(state.StateKeyProposalCounter(d.contract))
+	if sequence, err := state.StateProposalCounterValue(st); err != nil {
+		t.Fatal(err)
+	} else if sequence != 3 {
+		t.Fatalf("expected the proposal counter 3, got %d", sequence)
+	}
+}
+
+// proposeOp returns the Propose of a transfer proposal signed by a new proposer.
+func (d *testDAO) proposeOp(pid string) base.Operation {
+	proposer := d.newAccount("proposer", 100)
+
+	p := NewTestProposeProcessor(d.tp)
+	p.MakeOperation(proposer.Address(), proposer.Priv(), d.contract, pid,
+		d.newProposal(proposer.Address(), 200), d.tp.GenesisCurrency)
+
+	return p.Op
+}
+
+func TestProposeDupKeyTakesAssignedProposalID(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	if errs := d.checkDuplication(d.proposeOp("5"), d.proposeOp("6")); errs[0] != nil || errs[1] != nil {
+		t.Fatalf("expected the proposals with different ids in one block, got %v", errs)
+	}
+
+	// the proposal without an id is assigned "1".
+	if errs := d.checkDuplication(d.proposeOp(""), d.proposeOp("1")); errs[1] == nil {
+		t.Fatal("expected the proposal with the id assigned in the block rejected")
+	}
+
+	if errs := d.checkDuplication(d.proposeOp("1"), d.proposeOp("")); errs[1] == nil {
+		t.Fatal("expected the proposal without an id rejected with its id taken in the block")
+	}
+
+	if errs := d.checkDuplication(d.proposeOp(""), d.proposeOp("")); errs[1] == nil {
+		t.Fatal("expected the second proposal without an id rejected in the block")
+	}
+}
+
+func TestProposeDupKeyTakesProposalCounterWithCaps(t *testing.T) {
+	d := newTestDAO(t, testPolicy{maxActiveProposals: 10})
+
+	if errs := d.checkDuplication(d.proposeOp("5"), d.proposeOp("6")); errs[1] == nil {
+		t.Fatal("expected the proposals counted by the active proposal cap in different blocks")
+	}
+}
+
+func TestProposeSkipsStaleProposalsInCaps(t *testing.T) {
+	d := newTestDAO(t, testPolicy{maxActiveProposals: 1})
+	proposer := d.newAccount("proposer", 100)
+
+	// the proposal "1" can be pre-snapped until 130.
+	d.setProposal("1", proposer.Address(), types.Proposed,
+		types.NewDeposit(proposer.Address(), d.amount(1), types.DepositLocked))
+	d.setState(state.StateKeyActiveProposals(d.contract), state.NewActiveProposalsStateValue([]string{"1"}))
+
+	if err := d.propose(proposer, "", 125); err == nil {
+		t.Fatal("expected the proposal over the max active proposals rejected")
+	}
+
+	if err := d.propose(proposer, "", 135); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProposeChecksMaxProposerProposals(t *testing.T) {
+	d := newTestDAO(t, testPolicy{maxProposerProposals: 1})
+	proposer := d.newAccount("proposer", 100)
+	other := d.newAccount("other", 100)
+
+	d.setProposal("1", proposer.Address(), types.Proposed,
+		types.NewDeposit(proposer.Address(), d.amount(1), types.DepositLocked))
+	d.setState(state.StateKeyActiveProposals(d.contract), state.NewActiveProposalsStateValue([]string{"1"}))
+
+	if err := d.propose(proposer, "", 125); err == nil {
+		t.Fatal("expected the proposal over the max proposer proposals rejected")
+	}
+
+	if err := d.propose(other, "", 125); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProposeAllowsOptimisticTransferOnly(t *testing.T) {
+	d := newTestDAO(t, testPolicy{objectionThreshold: types.PercentRatio(10)})
+	proposer := d.newAccount("proposer", 100)
+	receiver := d.newAccount("receiver", 0)
+
+	transfer := types.NewCryptoProposal(
+		proposer.Address(), 200,
+		types.NewTransferCallData(d.contract, receiver.Address(), d.amount(1)),
+		true, nil,
+	)
+	if err := d.proposeProposal(proposer, "1", transfer, 50); err != nil {
+		t.Fatal(err)
+	}
+
+	governance := types.NewCryptoProposal(
+		proposer.Address(), 200,
+		types.NewGovernanceCallData(types.NewPolicyPatchFromPolicy(d.policy)),
+		true, nil,
+	)
+	if err := d.proposeProposal(proposer, "2", governance, 50); err == nil {
+		t.Fatal("expected optimistic governance proposal rejected")
+	}
+}
+
+func TestProposeChecksMinLeadTimeOnlyWhenSet(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	proposer := d.newAccount("proposer", 100)
+
+	if err := d.propose(proposer, "1", 250); err != nil {
+		t.Fatalf("expected the proposal started before now accepted without min lead time, %v", err)
+	}
+
+	d.setPolicy(testPolicy{minLeadTime: 10})
+
+	if err := d.propose(proposer, "2", 195); err == nil {
+		t.Fatal("expected the proposal under the min lead time rejected")
+	}
+
+	if err := d.propose(proposer, "3", 190); err != nil {
+		t.Fatal(err)
+	}
+}
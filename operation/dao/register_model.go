@@ -34,6 +34,23 @@ type RegisterModelFact struct {
 	executionDelayPeriod uint64
 	turnout              types.PercentRatio
 	quorum               types.PercentRatio
+	depositRule          types.DepositRule
+	depositPeriod        uint64
+	depositTarget        common.Big
+	guardians            types.Guardians
+	objectionThreshold   types.PercentRatio
+	executionGracePeriod uint64
+	executionRetries     uint64
+	sponsorsRequired     uint64
+	reviewers            types.Reviewers
+	voterAllowlistActive bool
+	periodUnit           types.PeriodUnit
+	minLeadTime          uint64
+	maxLeadTime          uint64
+	maxActiveProposals   uint64
+	maxProposerProposals uint64
+	executors            types.Executors
+	bounds               types.PolicyBounds
 	currency             ctypes.CurrencyID
 }
 
@@ -53,6 +70,21 @@ func NewRegisterModelFact(
 	postSnapshotPeriod,
 	executionDelayPeriod uint64,
 	turnout, quorum types.PercentRatio,
+	depositRule types.DepositRule,
+	depositPeriod uint64,
+	depositTarget common.Big,
+	guardians types.Guardians,
+	objectionThreshold types.PercentRatio,
+	executionGracePeriod uint64,
+	executionRetries uint64,
+	sponsorsRequired uint64,
+	reviewers types.Reviewers,
+	voterAllowlistActive bool,
+	periodUnit types.PeriodUnit,
+	minLeadTime, maxLeadTime uint64,
+	maxActiveProposals, maxProposerProposals uint64,
+	executors types.Executors,
+	bounds types.PolicyBounds,
 	currency ctypes.CurrencyID,
 ) RegisterModelFact {
 	bf := base.NewBaseFact(RegisterModelFactHint, token)
@@ -73,6 +105,23 @@ func NewRegisterModelFact(
 		postSnapshotPeriod:   postSnapshotPeriod,
 		turnout:              turnout,
 		quorum:               quorum,
+		depositRule:          depositRule,
+		depositPeriod:        depositPeriod,
+		depositTarget:        depositTarget,
+		guardians:            guardians,
+		objectionThreshold:   objectionThreshold,
+		executionGracePeriod: executionGracePeriod,
+		executionRetries:     executionRetries,
+		sponsorsRequired:     sponsorsRequired,
+		reviewers:            reviewers,
+		voterAllowlistActive: voterAllowlistActive,
+		periodUnit:           periodUnit,
+		minLeadTime:          minLeadTime,
+		maxLeadTime:          maxLeadTime,
+		maxActiveProposals:   maxActiveProposals,
+		maxProposerProposals: maxProposerProposals,
+		executors:            executors,
+		bounds:               bounds,
 		currency:             currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -88,24 +137,27 @@ func (fact RegisterModelFact) GenerateHash() util.Hash {
 	return valuehash.NewSHA256(fact.Bytes())
 }
 
+// Bytes omits the policy fields and the bounds added later when they are not set, so the facts
+// written before them keep their hashes.
 func (fact RegisterModelFact) Bytes() []byte {
+	if fact.bounds.IsUnbounded() {
+		return util.ConcatBytesSlice(
+			fact.Token(),
+			fact.sender.Bytes(),
+			fact.contract.Bytes(),
+			fact.option.Bytes(),
+			fact.policy().Bytes(),
+			fact.currency.Bytes(),
+		)
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		fact.contract.Bytes(),
 		fact.option.Bytes(),
-		fact.votingPowerToken.Bytes(),
-		fact.threshold.Bytes(),
-		fact.proposalFee.Bytes(),
-		fact.proposerWhitelist.Bytes(),
-		util.Uint64ToBytes(fact.proposalReviewPeriod),
-		util.Uint64ToBytes(fact.registrationPeriod),
-		util.Uint64ToBytes(fact.preSnapshotPeriod),
-		util.Uint64ToBytes(fact.votingPeriod),
-		util.Uint64ToBytes(fact.postSnapshotPeriod),
-		util.Uint64ToBytes(fact.executionDelayPeriod),
-		fact.turnout.Bytes(),
-		fact.quorum.Bytes(),
+		fact.policy().Bytes(),
+		fact.bounds.Bytes(),
 		fact.currency.Bytes(),
 	)
 }
@@ -125,6 +177,13 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 		fact.proposerWhitelist,
 		fact.turnout,
 		fact.quorum,
+		fact.depositRule,
+		fact.depositTarget,
+		fact.guardians,
+		fact.reviewers,
+		fact.periodUnit,
+		fact.executors,
+		fact.bounds,
 		fact.currency,
 	); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
@@ -176,6 +235,26 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 		}
 	}
 
+	if 0 < fact.depositPeriod && !fact.depositTarget.OverZero() {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("deposit target must be bigger than zero with deposit period, got %v", fact.depositTarget)))
+	}
+
+	if fact.objectionThreshold != 0 {
+		if err := fact.objectionThreshold.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+	}
+
+	if 0 < fact.maxLeadTime && fact.maxLeadTime < fact.minLeadTime {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("min lead time must not be bigger than max lead time, %d > %d", fact.minLeadTime, fact.maxLeadTime)))
+	}
+
+	if err := fact.bounds.Check(fact.policy()); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -247,6 +326,97 @@ func (fact RegisterModelFact) Quorum() types.PercentRatio {
 	return fact.quorum
 }
 
+func (fact RegisterModelFact) DepositRule() types.DepositRule {
+	return fact.depositRule
+}
+
+func (fact RegisterModelFact) DepositPeriod() uint64 {
+	return fact.depositPeriod
+}
+
+func (fact RegisterModelFact) DepositTarget() common.Big {
+	return fact.depositTarget
+}
+
+func (fact RegisterModelFact) Guardians() types.Guardians {
+	return fact.guardians
+}
+
+func (fact RegisterModelFact) ObjectionThreshold() types.PercentRatio {
+	return fact.objectionThreshold
+}
+
+func (fact RegisterModelFact) ExecutionGracePeriod() uint64 {
+	return fact.executionGracePeriod
+}
+
+func (fact RegisterModelFact) ExecutionRetries() uint64 {
+	return fact.executionRetries
+}
+
+func (fact RegisterModelFact) SponsorsRequired() uint64 {
+	return fact.sponsorsRequired
+}
+
+func (fact RegisterModelFact) Reviewers() types.Reviewers {
+	return fact.reviewers
+}
+
+func (fact RegisterModelFact) VoterAllowlistActive() bool {
+	return fact.voterAllowlistActive
+}
+
+func (fact RegisterModelFact) PeriodUnit() types.PeriodUnit {
+	return fact.periodUnit
+}
+
+func (fact RegisterModelFact) MinLeadTime() uint64 {
+	return fact.minLeadTime
+}
+
+func (fact RegisterModelFact) MaxLeadTime() uint64 {
+	return fact.maxLeadTime
+}
+
+func (fact RegisterModelFact) MaxActiveProposals() uint64 {
+	return fact.maxActiveProposals
+}
+
+func (fact RegisterModelFact) MaxProposerProposals() uint64 {
+	return fact.maxProposerProposals
+}
+
+func (fact RegisterModelFact) Executors() types.Executors {
+	return fact.executors
+}
+
+func (fact RegisterModelFact) Bounds() types.PolicyBounds {
+	return fact.bounds
+}
+
+// policy returns the dao policy the fact registers.
+func (fact RegisterModelFact) policy() types.Policy {
+	return types.NewPolicy(
+		fact.votingPowerToken, fact.threshold, fact.proposalFee, fact.proposerWhitelist,
+		fact.proposalReviewPeriod, fact.registrationPeriod, fact.preSnapshotPeriod, fact.votingPeriod,
+		fact.postSnapshotPeriod, fact.executionDelayPeriod, fact.turnout, fact.quorum,
+		fact.depositRule, fact.depositPeriod, fact.depositTarget,
+		fact.guardians,
+		fact.objectionThreshold,
+		fact.executionGracePeriod,
+		fact.executionRetries,
+		fact.sponsorsRequired,
+		fact.reviewers,
+		fact.voterAllowlistActive,
+		fact.periodUnit,
+		fact.minLeadTime,
+		fact.maxLeadTime,
+		fact.maxActiveProposals,
+		fact.maxProposerProposals,
+		fact.executors,
+	)
+}
+
 func (fact RegisterModelFact) Currency() ctypes.CurrencyID {
 	return fact.currency
 }
@@ -28,6 +28,23 @@ func (fact RegisterModelFact) MarshalBSON() ([]byte, error) {
 			"execution_delay_period": fact.executionDelayPeriod,
 			"turnout":                fact.turnout,
 			"quorum":                 fact.quorum,
+			"deposit_rule":           fact.depositRule,
+			"deposit_period":         fact.depositPeriod,
+			"deposit_target":         fact.depositTarget,
+			"guardians":              fact.guardians,
+			"objection_threshold":    fact.objectionThreshold,
+			"execution_grace_period": fact.executionGracePeriod,
+			"execution_retries":      fact.executionRetries,
+			"sponsors_required":      fact.sponsorsRequired,
+			"reviewers":              fact.reviewers,
+			"voter_allowlist_active": fact.voterAllowlistActive,
+			"period_unit":            fact.periodUnit,
+			"min_lead_time":          fact.minLeadTime,
+			"max_lead_time":          fact.maxLeadTime,
+			"max_active_proposals":   fact.maxActiveProposals,
+			"max_proposer_proposals": fact.maxProposerProposals,
+			"executors":              fact.executors,
+			"bounds":                 fact.bounds,
 			"currency":               fact.currency,
 			"hash":                   fact.BaseFact.Hash().String(),
 			"token":                  fact.BaseFact.Token(),
@@ -52,6 +69,23 @@ type RegisterModelFactBSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64   `bson:"execution_delay_period"`
 	Turnout              uint     `bson:"turnout"`
 	Quorum               uint     `bson:"quorum"`
+	DepositRule          bson.Raw `bson:"deposit_rule"`
+	DepositPeriod        uint64   `bson:"deposit_period"`
+	DepositTarget        string   `bson:"deposit_target"`
+	Guardians            bson.Raw `bson:"guardians"`
+	ObjectionThreshold   uint     `bson:"objection_threshold"`
+	ExecutionGracePeriod uint64   `bson:"execution_grace_period"`
+	ExecutionRetries     uint64   `bson:"execution_retries"`
+	SponsorsRequired     uint64   `bson:"sponsors_required"`
+	Reviewers            bson.Raw `bson:"reviewers"`
+	VoterAllowlistActive bool     `bson:"voter_allowlist_active"`
+	PeriodUnit           uint8    `bson:"period_unit"`
+	MinLeadTime          uint64   `bson:"min_lead_time"`
+	MaxLeadTime          uint64   `bson:"max_lead_time"`
+	MaxActiveProposals   uint64   `bson:"max_active_proposals"`
+	MaxProposerProposals uint64   `bson:"max_proposer_proposals"`
+	Executors            bson.Raw `bson:"executors"`
+	Bounds               bson.Raw `bson:"bounds"`
 	Currency             string   `bson:"currency"`
 }
 
@@ -91,6 +125,23 @@ func (fact *RegisterModelFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 		uf.ExecutionDelayPeriod,
 		uf.Turnout,
 		uf.Quorum,
+		uf.DepositRule,
+		uf.DepositPeriod,
+		uf.DepositTarget,
+		uf.Guardians,
+		uf.ObjectionThreshold,
+		uf.ExecutionGracePeriod,
+		uf.ExecutionRetries,
+		uf.SponsorsRequired,
+		uf.Reviewers,
+		uf.VoterAllowlistActive,
+		uf.PeriodUnit,
+		uf.MinLeadTime,
+		uf.MaxLeadTime,
+		uf.MaxActiveProposals,
+		uf.MaxProposerProposals,
+		uf.Executors,
+		uf.Bounds,
 		uf.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
@@ -14,6 +14,21 @@ func (fact *RegisterModelFact) unpack(enc encoder.Encoder,
 	bf, bw []byte,
 	prp, rp, prsp, vp, psp, edp uint64,
 	to, qou uint,
+	bdr []byte,
+	dp uint64,
+	dt string,
+	bgd []byte,
+	ot uint,
+	egp uint64,
+	er uint64,
+	sr uint64,
+	brv []byte,
+	va bool,
+	pu uint8,
+	mnlt, mxlt uint64,
+	mxap, mxpp uint64,
+	bex []byte,
+	bbd []byte,
 	cid string,
 ) error {
 	fact.currency = ctypes.CurrencyID(cid)
@@ -64,5 +79,60 @@ func (fact *RegisterModelFact) unpack(enc encoder.Encoder,
 		fact.proposerWhitelist = wl
 	}
 
+	// the fields below are missing in the facts written before they were added.
+	if dr, err := types.DecodeHinterOr(enc, bdr,
+		types.NewDepositRule(types.DepositKeep, types.DepositKeep, types.DepositKeep, types.DepositKeep)); err != nil {
+		return err
+	} else {
+		fact.depositRule = dr
+	}
+
+	fact.depositPeriod = dp
+
+	if big, err := types.DecodeBigOr(dt, common.ZeroBig); err != nil {
+		return err
+	} else {
+		fact.depositTarget = big
+	}
+
+	if gd, err := types.DecodeHinterOr(enc, bgd, types.NewGuardians(nil, 0, common.ZeroBig)); err != nil {
+		return err
+	} else {
+		fact.guardians = gd
+	}
+
+	fact.objectionThreshold = types.PercentRatio(ot)
+
+	fact.executionGracePeriod = egp
+
+	fact.executionRetries = er
+
+	fact.sponsorsRequired = sr
+
+	if rv, err := types.DecodeHinterOr(enc, brv, types.NewReviewers(nil)); err != nil {
+		return err
+	} else {
+		fact.reviewers = rv
+	}
+
+	fact.voterAllowlistActive = va
+	fact.periodUnit = types.PeriodUnit(pu)
+	fact.minLeadTime = mnlt
+	fact.maxLeadTime = mxlt
+	fact.maxActiveProposals = mxap
+	fact.maxProposerProposals = mxpp
+
+	if ex, err := types.DecodeHinterOr(enc, bex, types.NewExecutors(nil)); err != nil {
+		return err
+	} else {
+		fact.executors = ex
+	}
+
+	if bd, err := types.DecodeHinterOr(enc, bbd, types.NewUnboundedPolicyBounds()); err != nil {
+		return err
+	} else {
+		fact.bounds = bd
+	}
+
 	return nil
 }
@@ -29,6 +29,23 @@ type RegisterModelFactJSONMarshaler struct {
 	ExecutionDelayPeriod uint64             `json:"execution_delay_period"`
 	Turnout              types.PercentRatio `json:"turnout"`
 	Quorum               types.PercentRatio `json:"quorum"`
+	DepositRule          types.DepositRule  `json:"deposit_rule"`
+	DepositPeriod        uint64             `json:"deposit_period"`
+	DepositTarget        common.Big         `json:"deposit_target"`
+	Guardians            types.Guardians    `json:"guardians"`
+	ObjectionThreshold   types.PercentRatio `json:"objection_threshold"`
+	ExecutionGracePeriod uint64             `json:"execution_grace_period"`
+	ExecutionRetries     uint64             `json:"execution_retries"`
+	SponsorsRequired     uint64             `json:"sponsors_required"`
+	Reviewers            types.Reviewers    `json:"reviewers"`
+	VoterAllowlistActive bool               `json:"voter_allowlist_active"`
+	PeriodUnit           types.PeriodUnit   `json:"period_unit"`
+	MinLeadTime          uint64             `json:"min_lead_time"`
+	MaxLeadTime          uint64             `json:"max_lead_time"`
+	MaxActiveProposals   uint64             `json:"max_active_proposals"`
+	MaxProposerProposals uint64             `json:"max_proposer_proposals"`
+	Executors            types.Executors    `json:"executors"`
+	Bounds               types.PolicyBounds `json:"bounds"`
 	Currency             ctypes.CurrencyID  `json:"currency"`
 }
 
@@ -50,6 +67,23 @@ func (fact RegisterModelFact) MarshalJSON() ([]byte, error) {
 		ExecutionDelayPeriod:  fact.executionDelayPeriod,
 		Turnout:               fact.turnout,
 		Quorum:                fact.quorum,
+		DepositRule:           fact.depositRule,
+		DepositPeriod:         fact.depositPeriod,
+		DepositTarget:         fact.depositTarget,
+		Guardians:             fact.guardians,
+		ObjectionThreshold:    fact.objectionThreshold,
+		ExecutionGracePeriod:  fact.executionGracePeriod,
+		ExecutionRetries:      fact.executionRetries,
+		SponsorsRequired:      fact.sponsorsRequired,
+		Reviewers:             fact.reviewers,
+		VoterAllowlistActive:  fact.voterAllowlistActive,
+		PeriodUnit:            fact.periodUnit,
+		MinLeadTime:           fact.minLeadTime,
+		MaxLeadTime:           fact.maxLeadTime,
+		MaxActiveProposals:    fact.maxActiveProposals,
+		MaxProposerProposals:  fact.maxProposerProposals,
+		Executors:             fact.executors,
+		Bounds:                fact.bounds,
 		Currency:              fact.currency,
 	})
 }
@@ -71,6 +105,23 @@ type RegisterModelFactJSONUnMarshaler struct {
 	ExecutionDelayPeriod uint64          `json:"execution_delay_period"`
 	Turnout              uint            `json:"turnout"`
 	Quorum               uint            `json:"quorum"`
+	DepositRule          json.RawMessage `json:"deposit_rule"`
+	DepositPeriod        uint64          `json:"deposit_period"`
+	DepositTarget        string          `json:"deposit_target"`
+	Guardians            json.RawMessage `json:"guardians"`
+	ObjectionThreshold   uint            `json:"objection_threshold"`
+	ExecutionGracePeriod uint64          `json:"execution_grace_period"`
+	ExecutionRetries     uint64          `json:"execution_retries"`
+	SponsorsRequired     uint64          `json:"sponsors_required"`
+	Reviewers            json.RawMessage `json:"reviewers"`
+	VoterAllowlistActive bool            `json:"voter_allowlist_active"`
+	PeriodUnit           uint8           `json:"period_unit"`
+	MinLeadTime          uint64          `json:"min_lead_time"`
+	MaxLeadTime          uint64          `json:"max_lead_time"`
+	MaxActiveProposals   uint64          `json:"max_active_proposals"`
+	MaxProposerProposals uint64          `json:"max_proposer_proposals"`
+	Executors            json.RawMessage `json:"executors"`
+	Bounds               json.RawMessage `json:"bounds"`
 	Currency             string          `json:"currency"`
 }
 
@@ -97,6 +148,23 @@ func (fact *RegisterModelFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		uf.ExecutionDelayPeriod,
 		uf.Turnout,
 		uf.Quorum,
+		uf.DepositRule,
+		uf.DepositPeriod,
+		uf.DepositTarget,
+		uf.Guardians,
+		uf.ObjectionThreshold,
+		uf.ExecutionGracePeriod,
+		uf.ExecutionRetries,
+		uf.SponsorsRequired,
+		uf.Reviewers,
+		uf.VoterAllowlistActive,
+		uf.PeriodUnit,
+		uf.MinLeadTime,
+		uf.MaxLeadTime,
+		uf.MaxActiveProposals,
+		uf.MaxProposerProposals,
+		uf.Executors,
+		uf.Bounds,
 		uf.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
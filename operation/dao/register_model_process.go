@@ -126,16 +126,12 @@ func (opp *RegisterModelProcessor) Process(
 ) {
 	fact, _ := op.Fact().(RegisterModelFact)
 
-	policy := types.NewPolicy(
-		fact.votingPowerToken, fact.threshold, fact.proposalFee, fact.proposerWhitelist,
-		fact.proposalReviewPeriod, fact.registrationPeriod, fact.preSnapshotPeriod, fact.votingPeriod,
-		fact.postSnapshotPeriod, fact.executionDelayPeriod, fact.turnout, fact.quorum,
-	)
+	policy := fact.policy()
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid dao policy, %s: %w", fact.Contract(), err), nil
 	}
 
-	design := types.NewDesign(fact.option, policy)
+	design := types.NewDesign(fact.option, policy, fact.bounds)
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid design, %s: %w", fact.Contract(), err), nil
 	}
@@ -154,7 +150,7 @@ func (opp *RegisterModelProcessor) Process(
 
 	sts = append(sts, cstate.NewStateMergeValue(
 		state.StateKeyDesign(fact.Contract()),
-		state.NewDesignStateValue(design),
+		state.NewDesignStateValue(design, false, 1, opp.Height(), fact.Hash()),
 	))
 
 	st, err := cstate.ExistsState(cestate.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
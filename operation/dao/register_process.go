@@ -123,6 +123,29 @@ func (opp *RegisterProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
 				Errorf("already canceled proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	} else if p.Status() == types.Rejected {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("already rejected proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	} else if p.Status() == types.PendingDeposit || p.Status() == types.Lapsed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("deposit of proposal %q in contract account %v has not reached the target, %q",
+					fact.ProposalID(), fact.Contract(), p.Status())), nil
+	}
+
+	// both the delegator and the delegatee must be in the voter allowlist when it is active.
+	for _, ac := range []base.Address{fact.Sender(), fact.Approved()} {
+		switch eligible, err := isEligibleVoter(fact.Contract(), p.Policy(), ac, getStateFunc); {
+		case err != nil:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("voter allowlist member %v in contract account %v", ac, fact.Contract())), nil
+		case !eligible:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+					Errorf("account not in voter allowlist, %s", ac)), nil
+		}
 	}
 
 	switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
@@ -200,13 +223,22 @@ func (opp *RegisterProcessor) Process(
 	}
 
 	proposal := *opp.proposal
-	nowTime := uint64(proposal.ProposalFact().ProposedAt().Unix())
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
 
 	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Registration, nowTime)
 	if period != types.Registration {
 		return nil, base.NewBaseOperationProcessReasonError("current time is not within the Registration period, Registration period; start(%d), end(%d), but now(%d)", start, end, nowTime), nil
 	}
 
+	// a proposal which has not collected enough sponsors during the review period lapses.
+	if sts, err := lapseUnsponsored(
+		fact.Contract(), fact.ProposalID(), p, opp.Height(), fact.Hash(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to check sponsorship of proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	} else if len(sts) > 0 {
+		return sts, nil, nil
+	}
+
 	var sts []base.StateMergeValue
 
 	smv, err := cstate.CreateNotExistAccount(fact.Approved(), getStateFunc)
@@ -0,0 +1,195 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RejectFactHint = hint.MustNewHint("mitum-dao-reject-operation-fact-v0.0.1")
+	RejectHint     = hint.MustNewHint("mitum-dao-reject-operation-v0.0.1")
+)
+
+type RejectFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	reason     string
+	currency   types.CurrencyID
+}
+
+func NewRejectFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	reason string,
+	currency types.CurrencyID,
+) RejectFact {
+	bf := base.NewBaseFact(RejectFactHint, token)
+	fact := RejectFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		reason:     reason,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RejectFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RejectFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RejectFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		[]byte(fact.reason),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RejectFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if len(fact.proposalID) == 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("empty proposal ID")))
+	}
+
+	if !types.ReValidSpcecialCh.Match([]byte(fact.proposalID)) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(
+				errors.Errorf("proposal ID %v must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.proposalID)))
+	}
+
+	if len(fact.reason) == 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("empty reject reason")))
+	}
+
+	if l := len(fact.reason); l > daotypes.MaxRejectReasonLen {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("reject reason length over max, %d > %d", l, daotypes.MaxRejectReasonLen)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RejectFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RejectFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RejectFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RejectFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact RejectFact) Reason() string {
+	return fact.reason
+}
+
+func (fact RejectFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact RejectFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+func (fact RejectFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RejectFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RejectFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RejectFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RejectFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact RejectFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[processor.DuplicationTypeDAOContractProposal] = []string{fmt.Sprintf("%s:%s", fact.Contract().String(), fact.ProposalID())}
+
+	return r, nil
+}
+
+type Reject struct {
+	extras.ExtendedOperation
+}
+
+func (op Reject) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewReject(fact RejectFact) Reject {
+	return Reject{
+		ExtendedOperation: extras.NewExtendedOperation(RejectHint, fact),
+	}
+}
@@ -0,0 +1,204 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var rejectProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RejectProcessor)
+	},
+}
+
+func (Reject) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RejectProcessor struct {
+	*base.BaseOperationProcessor
+	proposal *base.ProposalSignFact
+}
+
+func NewRejectProcessor() ctypes.GetNewProcessorWithProposal {
+	return func(
+		height base.Height,
+		proposal *base.ProposalSignFact,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RejectProcessor")
+
+		nopp := rejectProcessorPool.Get()
+		opp, ok := nopp.(*RejectProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RejectProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposal = proposal
+
+		return opp, nil
+	}
+}
+
+func (opp *RejectProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RejectFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RejectFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	} else if _, err := state.StateDesignValue(st); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	st, err := cstate.ExistsState(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	// the policy reviewers, once set by governance, are the only reviewers; before that the
+	// sender needs the reviewer role.
+	if reviewers := p.Policy().Reviewers(); reviewers.Active() {
+		if !reviewers.IsExist(fact.Sender()) {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+					Errorf("sender %v is not a reviewer of contract account %v", fact.Sender(), fact.Contract())), nil
+		}
+	} else if err := checkRole(fact.Contract(), fact.Sender(), types.RoleReviewer, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	if p.Status() == types.Rejected {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("already rejected proposal %q in contract account %v",
+					fact.ProposalID(), fact.Contract())), nil
+	} else if p.Status() != types.Proposed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v is %q, but can only be rejected at \"proposed\" status",
+					fact.ProposalID(), fact.Contract(), p.Status())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RejectProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(RejectFact)
+
+	st, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s,%v: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	proposal := *opp.proposal
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.ProposalReview, nowTime)
+	if period != types.ProposalReview {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"current time is not within the review period; review-period(%d ~ %d), now(%d)",
+			start, end, nowTime), nil
+	}
+
+	sts, deposit, err := settleDeposit(
+		fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Rejected), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+		state.NewProposalStateValue(
+			types.Rejected,
+			fmt.Sprintf("rejected by reviewer %s; %s", fact.Sender(), fact.Reason()),
+			types.NewReasonDetail(types.ReasonRejectedByReviewer),
+			p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+		),
+	))
+
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), fact.ProposalID(), p.Status(), types.Rejected, types.ReasonRejectedByReviewer,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+	sts = append(sts, hst...)
+
+	return sts, nil, nil
+}
+
+func (opp *RejectProcessor) Close() error {
+	opp.proposal = nil
+	rejectProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RemoveVoterAllowlistFactHint = hint.MustNewHint("mitum-dao-remove-voter-allowlist-operation-fact-v0.0.1")
+	RemoveVoterAllowlistHint     = hint.MustNewHint("mitum-dao-remove-voter-allowlist-operation-v0.0.1")
+)
+
+type RemoveVoterAllowlistFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	accounts []base.Address
+	currency types.CurrencyID
+}
+
+func NewRemoveVoterAllowlistFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	accounts []base.Address,
+	currency types.CurrencyID,
+) RemoveVoterAllowlistFact {
+	bf := base.NewBaseFact(RemoveVoterAllowlistFactHint, token)
+	fact := RemoveVoterAllowlistFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		accounts: accounts,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RemoveVoterAllowlistFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RemoveVoterAllowlistFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RemoveVoterAllowlistFact) Bytes() []byte {
+	ads := make([][]byte, len(fact.accounts))
+	for i := range fact.accounts {
+		ads[i] = fact.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(ads...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RemoveVoterAllowlistFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidVoterAllowlistAccounts(fact.contract, fact.accounts); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RemoveVoterAllowlistFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RemoveVoterAllowlistFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveVoterAllowlistFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RemoveVoterAllowlistFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+func (fact RemoveVoterAllowlistFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact RemoveVoterAllowlistFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2+len(fact.accounts))
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	for i, ac := range fact.accounts {
+		as[i+2] = ac
+	}
+
+	return as, nil
+}
+
+func (fact RemoveVoterAllowlistFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RemoveVoterAllowlistFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveVoterAllowlistFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveVoterAllowlistFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveVoterAllowlistFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact RemoveVoterAllowlistFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	keys := make([]string, len(fact.accounts))
+	for i, ac := range fact.accounts {
+		keys[i] = fmt.Sprintf("%s:%s", fact.Contract().String(), ac.String())
+	}
+	r[processor.DuplicationTypeDAOContractVoterAllowlist] = keys
+
+	return r, nil
+}
+
+type RemoveVoterAllowlist struct {
+	extras.ExtendedOperation
+}
+
+func (op RemoveVoterAllowlist) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewRemoveVoterAllowlist(fact RemoveVoterAllowlistFact) RemoveVoterAllowlist {
+	return RemoveVoterAllowlist{
+		ExtendedOperation: extras.NewExtendedOperation(RemoveVoterAllowlistHint, fact),
+	}
+}
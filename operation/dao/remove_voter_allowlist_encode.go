@@ -0,0 +1,40 @@
+package dao
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *RemoveVoterAllowlistFact) unpack(enc encoder.Encoder,
+	sa, ca string, acs []string, cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	accounts := make([]base.Address, len(acs))
+	for i, ac := range acs {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return err
+		default:
+			accounts[i] = a
+		}
+	}
+	fact.accounts = accounts
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var removeVoterAllowlistProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveVoterAllowlistProcessor)
+	},
+}
+
+func (RemoveVoterAllowlist) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RemoveVoterAllowlistProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRemoveVoterAllowlistProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RemoveVoterAllowlistProcessor")
+
+		nopp := removeVoterAllowlistProcessorPool.Get()
+		opp, ok := nopp.(*RemoveVoterAllowlistProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RemoveVoterAllowlistProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RemoveVoterAllowlistProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RemoveVoterAllowlistFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RemoveVoterAllowlistFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if err := cstate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	for _, ac := range fact.Accounts() {
+		switch member, err := isVoterAllowlisted(fact.Contract(), ac, getStateFunc); {
+		case err != nil:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("voter allowlist member %v in contract account %v", ac, fact.Contract())), nil
+		case !member:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v is not in voter allowlist of contract account %v", ac, fact.Contract())), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RemoveVoterAllowlistProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RemoveVoterAllowlist")
+
+	fact, ok := op.Fact().(RemoveVoterAllowlistFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected RemoveVoterAllowlistFact, not %T", op.Fact())
+	}
+
+	return voterAllowlistMemberStateMergeValues(fact.Contract(), fact.Accounts(), false), nil, nil
+}
+
+func (opp *RemoveVoterAllowlistProcessor) Close() error {
+	removeVoterAllowlistProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RemoveWhitelistFactHint = hint.MustNewHint("mitum-dao-remove-whitelist-operation-fact-v0.0.1")
+	RemoveWhitelistHint     = hint.MustNewHint("mitum-dao-remove-whitelist-operation-v0.0.1")
+)
+
+type RemoveWhitelistFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	accounts []base.Address
+	currency types.CurrencyID
+}
+
+func NewRemoveWhitelistFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	accounts []base.Address,
+	currency types.CurrencyID,
+) RemoveWhitelistFact {
+	bf := base.NewBaseFact(RemoveWhitelistFactHint, token)
+	fact := RemoveWhitelistFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		accounts: accounts,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RemoveWhitelistFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RemoveWhitelistFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RemoveWhitelistFact) Bytes() []byte {
+	ads := make([][]byte, len(fact.accounts))
+	for i := range fact.accounts {
+		ads[i] = fact.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(ads...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RemoveWhitelistFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidWhitelistAccounts(fact.contract, fact.accounts); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RemoveWhitelistFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RemoveWhitelistFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveWhitelistFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RemoveWhitelistFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+func (fact RemoveWhitelistFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact RemoveWhitelistFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2+len(fact.accounts))
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	for i, ac := range fact.accounts {
+		as[i+2] = ac
+	}
+
+	return as, nil
+}
+
+func (fact RemoveWhitelistFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RemoveWhitelistFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveWhitelistFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveWhitelistFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveWhitelistFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact RemoveWhitelistFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	keys := make([]string, len(fact.accounts))
+	for i, ac := range fact.accounts {
+		keys[i] = fmt.Sprintf("%s:%s", fact.Contract().String(), ac.String())
+	}
+	r[processor.DuplicationTypeDAOContractWhitelist] = keys
+
+	return r, nil
+}
+
+type RemoveWhitelist struct {
+	extras.ExtendedOperation
+}
+
+func (op RemoveWhitelist) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewRemoveWhitelist(fact RemoveWhitelistFact) RemoveWhitelist {
+	return RemoveWhitelist{
+		ExtendedOperation: extras.NewExtendedOperation(RemoveWhitelistHint, fact),
+	}
+}
@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var removeWhitelistProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveWhitelistProcessor)
+	},
+}
+
+func (RemoveWhitelist) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RemoveWhitelistProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRemoveWhitelistProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RemoveWhitelistProcessor")
+
+		nopp := removeWhitelistProcessorPool.Get()
+		opp, ok := nopp.(*RemoveWhitelistProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RemoveWhitelistProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RemoveWhitelistProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RemoveWhitelistFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RemoveWhitelistFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	for _, ac := range fact.Accounts() {
+		// accounts of the policy whitelist are removed by updating the policy.
+		if design.Policy().Whitelist().IsExist(ac) {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v is in the policy whitelist of contract account %v", ac, fact.Contract())), nil
+		}
+
+		switch whitelisted, err := isWhitelisted(fact.Contract(), design.Policy().Whitelist(), ac, getStateFunc); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("whitelist member %v in contract account %v", ac, fact.Contract())), nil
+		case !whitelisted:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v is not whitelisted in contract account %v", ac, fact.Contract())), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RemoveWhitelistProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RemoveWhitelist")
+
+	fact, ok := op.Fact().(RemoveWhitelistFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected RemoveWhitelistFact, not %T", op.Fact())
+	}
+
+	return whitelistMemberStateMergeValues(fact.Contract(), fact.Accounts(), false), nil, nil
+}
+
+func (opp *RemoveWhitelistProcessor) Close() error {
+	removeWhitelistProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RenounceAdminFactHint = hint.MustNewHint("mitum-dao-renounce-admin-operation-fact-v0.0.1")
+	RenounceAdminHint     = hint.MustNewHint("mitum-dao-renounce-admin-operation-v0.0.1")
+)
+
+type RenounceAdminFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency types.CurrencyID
+}
+
+func NewRenounceAdminFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	currency types.CurrencyID,
+) RenounceAdminFact {
+	bf := base.NewBaseFact(RenounceAdminFactHint, token)
+	fact := RenounceAdminFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RenounceAdminFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RenounceAdminFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RenounceAdminFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RenounceAdminFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RenounceAdminFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RenounceAdminFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RenounceAdminFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RenounceAdminFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact RenounceAdminFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+func (fact RenounceAdminFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RenounceAdminFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RenounceAdminFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RenounceAdminFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RenounceAdminFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact RenounceAdminFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+type RenounceAdmin struct {
+	extras.ExtendedOperation
+}
+
+func (op RenounceAdmin) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewRenounceAdmin(fact RenounceAdminFact) RenounceAdmin {
+	return RenounceAdmin{
+		ExtendedOperation: extras.NewExtendedOperation(RenounceAdminHint, fact),
+	}
+}
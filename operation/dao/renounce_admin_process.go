@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var renounceAdminProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenounceAdminProcessor)
+	},
+}
+
+func (RenounceAdmin) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RenounceAdminProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRenounceAdminProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RenounceAdminProcessor")
+
+		nopp := renounceAdminProcessorPool.Get()
+		opp, ok := nopp.(*RenounceAdminProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RenounceAdminProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RenounceAdminProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RenounceAdminFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RenounceAdminFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	switch renounced, err := state.StateAdminRenouncedValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	case renounced:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).Errorf("admin already renounced for dao contract %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RenounceAdminProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RenounceAdmin")
+
+	fact, ok := op.Fact().(RenounceAdminFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected RenounceAdminFact, not %T", op.Fact())
+	}
+
+	st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	dv, err := state.StateDesignStateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	// renouncing the admin does not change the policy, so the policy version is kept.
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyDesign(fact.Contract()),
+			state.NewDesignStateValue(dv.Design(), true, dv.PolicyVersion(), dv.Height(), dv.FactHash()),
+		),
+	}, nil, nil
+}
+
+func (opp *RenounceAdminProcessor) Close() error {
+	renounceAdminProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+)
+
+func TestRenounceAdminRejectsAdminOperations(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	member := d.newAccount("member", 100)
+	admin := d.newAccount("admin", 100)
+
+	d.setState(
+		state.StateKeyRoles(d.contract, admin.Address()),
+		state.NewRolesStateValue(admin.Address(), []types.Role{types.RoleAdmin}),
+	)
+
+	addWhitelist := func(sender test.Account) error {
+		p := NewTestAddWhitelistProcessor(d.tp)
+		p.Create().
+			SetAccounts([]test.Account{member}).
+			MakeOperation(sender.Address(), sender.Priv(), d.contract, d.tp.GenesisCurrency).
+			RunPreProcess()
+
+		return p.Error()
+	}
+
+	grantRole := func() error {
+		p := NewTestGrantRoleProcessor(d.tp)
+		p.Create().
+			SetRole(member, types.RoleProposer).
+			MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency).
+			RunPreProcess()
+
+		return p.Error()
+	}
+
+	renounce := func() error {
+		p := NewTestRenounceAdminProcessor(d.tp)
+		p.Create().
+			MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency).
+			RunPreProcess()
+		if err := p.Error(); err != nil {
+			return err
+		}
+
+		return p.RunProcess().Error()
+	}
+
+	for _, sender := range []test.Account{d.owner, admin} {
+		if err := addWhitelist(sender); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := grantRole(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := renounce(); err != nil {
+		t.Fatal(err)
+	}
+
+	st, _, _ := d.tp.GetStateFunc(state.StateKeyDesign(d.contract))
+	if renounced, err := state.StateAdminRenouncedValue(st); err != nil {
+		t.Fatal(err)
+	} else if !renounced {
+		t.Fatal("expected the admin renounced")
+	}
+
+	for _, sender := range []test.Account{d.owner, admin} {
+		if err := addWhitelist(sender); err == nil {
+			t.Fatalf("expected AddWhitelist of %v rejected after renounce", sender.Address())
+		}
+	}
+
+	if err := grantRole(); err == nil {
+		t.Fatal("expected GrantRole of the owner rejected after renounce")
+	}
+
+	if err := renounce(); err == nil {
+		t.Fatal("expected RenounceAdmin rejected after renounce")
+	}
+}
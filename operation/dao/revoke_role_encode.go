@@ -0,0 +1,38 @@
+package dao
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *RevokeRoleFact) unpack(enc encoder.Encoder,
+	sa, ca, ac, rl string, cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.account = a
+	}
+	fact.role = daotypes.Role(rl)
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var revokeRoleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevokeRoleProcessor)
+	},
+}
+
+func (RevokeRole) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RevokeRoleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRevokeRoleProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RevokeRoleProcessor")
+
+		nopp := revokeRoleProcessorPool.Get()
+		opp, ok := nopp.(*RevokeRoleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RevokeRoleProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RevokeRoleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RevokeRoleFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RevokeRoleFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if err := cstate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	roles, err := rolesOf(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("roles of %v in contract account %v", fact.Account(), fact.Contract())), nil
+	}
+
+	var held bool
+	for _, r := range roles {
+		if r == fact.Role() {
+			held = true
+			break
+		}
+	}
+
+	if !held {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("account %v does not hold %v role in contract account %v",
+					fact.Account(), fact.Role(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RevokeRoleProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RevokeRole")
+
+	fact, ok := op.Fact().(RevokeRoleFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected RevokeRoleFact, not %T", op.Fact())
+	}
+
+	roles, err := rolesOf(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("roles of %v not found; %w", fact.Account(), err), nil
+	}
+
+	holders, err := roleHoldersOf(fact.Contract(), fact.Role(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("holders of %v role not found; %w", fact.Role(), err), nil
+	}
+
+	var remains []types.Role
+	for _, r := range roles {
+		if r != fact.Role() {
+			remains = append(remains, r)
+		}
+	}
+
+	var remainHolders uint64
+	if holders > 0 {
+		remainHolders = holders - 1
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyRoles(fact.Contract(), fact.Account()),
+			state.NewRolesStateValue(fact.Account(), remains),
+		),
+		cstate.NewStateMergeValue(
+			state.StateKeyRoleHolders(fact.Contract(), fact.Role()),
+			state.NewRoleHoldersStateValue(fact.Role(), remainHolders),
+		),
+	}, nil, nil
+}
+
+func (opp *RevokeRoleProcessor) Close() error {
+	revokeRoleProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// rolesOf returns the roles granted to the account in the dao.
+func rolesOf(contract, account base.Address, getStateFunc base.GetStateFunc) ([]types.Role, error) {
+	switch st, found, err := getStateFunc(state.StateKeyRoles(contract, account)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		rs, err := state.StateRolesValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		return rs.Roles(), nil
+	}
+}
+
+// roleHoldersOf returns the number of accounts granted the role in the dao.
+func roleHoldersOf(contract base.Address, role types.Role, getStateFunc base.GetStateFunc) (uint64, error) {
+	switch st, found, err := getStateFunc(state.StateKeyRoleHolders(contract, role)); {
+	case err != nil:
+		return 0, err
+	case !found:
+		return 0, nil
+	default:
+		rh, err := state.StateRoleHoldersValue(st)
+		if err != nil {
+			return 0, err
+		}
+
+		return rh.Holders(), nil
+	}
+}
+
+// adminRenounced reports whether the admin of the dao is renounced.
+func adminRenounced(contract base.Address, getStateFunc base.GetStateFunc) (bool, error) {
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	return state.StateAdminRenouncedValue(st)
+}
+
+// hasRole reports whether the account holds the role in the dao. The owner and
+// the handlers of the contract account are admins without a grant. Nobody is an
+// admin once the admin is renounced.
+func hasRole(contract, account base.Address, role types.Role, getStateFunc base.GetStateFunc) (bool, error) {
+	if role == types.RoleAdmin {
+		switch renounced, err := adminRenounced(contract, getStateFunc); {
+		case err != nil:
+			return false, err
+		case renounced:
+			return false, nil
+		}
+
+		st, err := cstate.ExistsState(cestate.StateKeyContractAccount(contract), "contract account", getStateFunc)
+		if err != nil {
+			return false, err
+		}
+
+		ca, err := cestate.StateContractAccountValue(st)
+		if err != nil {
+			return false, err
+		}
+
+		if ca.Owner().Equal(account) || ca.IsHandler(account) {
+			return true, nil
+		}
+	}
+
+	roles, err := rolesOf(contract, account, getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range roles {
+		if r == role {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkRole returns the reason error when the sender does not hold the role in
+// the dao.
+func checkRole(
+	contract, sender base.Address, role types.Role, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
+	switch ok, err := hasRole(contract, sender, role, getStateFunc); {
+	case err != nil:
+		return base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("roles of %v in contract account %v: %v", sender, contract, err))
+	case !ok:
+		return base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v does not hold %v role in contract account %v", sender, role, contract))
+	default:
+		return nil
+	}
+}
+
+// checkExecutor returns the reason error when the policy executors are set or any
+// account holds the executor role, and the sender is neither a policy executor nor
+// an executor role holder.
+func checkExecutor(
+	contract, sender base.Address, policy types.Policy, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
+	executors := policy.Executors()
+	if executors.IsExist(sender) {
+		return nil
+	}
+
+	switch holders, err := roleHoldersOf(contract, types.RoleExecutor, getStateFunc); {
+	case err != nil:
+		return base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("executor holders in contract account %v: %v", contract, err))
+	case holders < 1 && !executors.Active():
+		return nil
+	default:
+		return checkRole(contract, sender, types.RoleExecutor, getStateFunc)
+	}
+}
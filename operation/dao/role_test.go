@@ -0,0 +1,205 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestLifecycleOperationsCheckExecutors(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	executor := d.newAccount("executor", 100)
+	outsider := d.newAccount("outsider", 100)
+
+	d.setPolicy(testPolicy{executors: types.NewExecutors([]base.Address{executor.Address()})})
+
+	// a pending deposit proposal passes PreProcess of the lifecycle operations.
+	proposer := d.newAccount("proposer", 0)
+	d.setProposal("1", proposer.Address(), types.PendingDeposit,
+		types.NewDeposit(proposer.Address(), d.amount(0), types.DepositLocked))
+
+	for _, c := range []struct {
+		name       string
+		preProcess func(test.Account) error
+	}{
+		{"pre-snap", func(sender test.Account) error {
+			p := NewTestPreSnapProcessor(d.tp)
+			p.Create(blockMaps(105)).
+				MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+				RunPreProcess()
+
+			return p.Error()
+		}},
+		{"post-snap", func(sender test.Account) error {
+			p := NewTestPostSnapProcessor(d.tp)
+			p.Create(blockMaps(105)).
+				MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+				RunPreProcess()
+
+			return p.Error()
+		}},
+		{"execute", func(sender test.Account) error {
+			p := NewTestExecuteProcessor(d.tp)
+			p.Create(blockMaps(105)).
+				MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+				RunPreProcess()
+
+			return p.Error()
+		}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.preProcess(outsider); err == nil {
+				t.Fatal("expected the sender out of the executors rejected")
+			}
+
+			if err := c.preProcess(executor); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestExecutorRoleGatesLifecycleOperations(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	executor := d.newAccount("executor", 100)
+	outsider := d.newAccount("outsider", 100)
+
+	proposer := d.newAccount("proposer", 0)
+	d.setProposal("1", proposer.Address(), types.PendingDeposit,
+		types.NewDeposit(proposer.Address(), d.amount(0), types.DepositLocked))
+
+	preSnap := func(sender test.Account) error {
+		p := NewTestPreSnapProcessor(d.tp)
+		p.Create(blockMaps(105)).
+			MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+			RunPreProcess()
+
+		return p.Error()
+	}
+
+	if err := preSnap(outsider); err != nil {
+		t.Fatalf("expected anyone pre-snaps without executors: %v", err)
+	}
+
+	g := NewTestGrantRoleProcessor(d.tp)
+	g.Create().
+		SetRole(executor, types.RoleExecutor).
+		MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency).
+		RunPreProcess()
+	if err := g.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.RunProcess().Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := preSnap(outsider); err == nil {
+		t.Fatal("expected the sender without the executor role rejected")
+	}
+
+	if err := preSnap(executor); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGrantRoleLimitsCommitteeRoles(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	d.setState(
+		state.StateKeyRoleHolders(d.contract, types.RoleReviewer),
+		state.NewRoleHoldersStateValue(types.RoleReviewer, types.MaxReviewers),
+	)
+
+	grant := func(role types.Role) error {
+		account := d.newAccount("account", 0)
+
+		p := NewTestGrantRoleProcessor(d.tp)
+		p.Create().
+			SetRole(account, role).
+			MakeOperation(d.owner.Address(), d.owner.Priv(), d.contract, d.tp.GenesisCurrency).
+			RunPreProcess()
+
+		return p.Error()
+	}
+
+	if err := grant(types.RoleReviewer); err == nil {
+		t.Fatal("expected the reviewer role over max rejected")
+	}
+
+	if err := grant(types.RoleProposer); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRejectByPolicyReviewersOnly(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	reviewer := d.newAccount("reviewer", 100)
+	holder := d.newAccount("holder", 100)
+
+	d.setState(
+		state.StateKeyRoles(d.contract, holder.Address()),
+		state.NewRolesStateValue(holder.Address(), []types.Role{types.RoleReviewer}),
+	)
+
+	proposer := d.newAccount("proposer", 0)
+	d.setProposal("1", proposer.Address(), types.Proposed,
+		types.NewDeposit(proposer.Address(), d.amount(0), types.DepositLocked))
+
+	reject := func(sender test.Account) error {
+		p := NewTestRejectProcessor(d.tp)
+		p.Create(blockMaps(105)).
+			MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", "spam", d.tp.GenesisCurrency).
+			RunPreProcess()
+
+		return p.Error()
+	}
+
+	if err := reject(holder); err != nil {
+		t.Fatalf("expected the reviewer role holder rejects without policy reviewers: %v", err)
+	}
+
+	d.setPolicy(testPolicy{reviewers: types.NewReviewers([]base.Address{reviewer.Address()})})
+	d.setProposal("1", proposer.Address(), types.Proposed,
+		types.NewDeposit(proposer.Address(), d.amount(0), types.DepositLocked))
+
+	if err := reject(holder); err == nil {
+		t.Fatal("expected the reviewer role holder rejected with policy reviewers")
+	}
+
+	if err := reject(reviewer); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCancelProposalByAdmin(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	outsider := d.newAccount("outsider", 100)
+	proposer := d.newAccount("proposer", 0)
+	d.setProposal("1", proposer.Address(), types.Proposed,
+		types.NewDeposit(proposer.Address(), d.amount(0), types.DepositLocked))
+
+	cancel := func(sender test.Account) error {
+		p := NewTestCancelProposalProcessor(d.tp)
+		p.Create(blockMaps(105)).
+			MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", d.tp.GenesisCurrency).
+			RunPreProcess()
+
+		return p.Error()
+	}
+
+	if err := cancel(outsider); err == nil {
+		t.Fatal("expected the sender neither the proposer nor an admin rejected")
+	}
+
+	if err := cancel(d.owner); err != nil {
+		t.Fatal(err)
+	}
+}
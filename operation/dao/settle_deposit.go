@@ -0,0 +1,183 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// settleDeposit applies the deposit action to the locked proposal deposit held by the contract account
+// and unlocks it. Refund moves the deposit back to the depositor, burn moves it to the zero account of
+// the currency, which no one can spend from, and keep leaves it in the contract balance as a part of
+// the treasury. A crowdfunded deposit is refunded to each contributor by its contribution.
+func settleDeposit(
+	contract base.Address, pid string, deposit types.Deposit, action types.DepositAction, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, types.Deposit, error) {
+	if !deposit.IsLocked() {
+		return nil, deposit, nil
+	}
+
+	amount := deposit.Amount()
+
+	if !amount.Big().OverZero() {
+		return nil, deposit.Settled(action), nil
+	}
+
+	sts := []base.StateMergeValue{
+		lockedDepositStateMergeValue(contract, state.NewUnlockDepositStateValue(amount), amount.Currency()),
+	}
+
+	if action == types.DepositKeep {
+		return sts, deposit.Settled(action), nil
+	}
+
+	cBalanceKey := currency.BalanceStateKey(contract, amount.Currency())
+
+	switch st, found, err := getStateFunc(cBalanceKey); {
+	case err != nil:
+		return nil, deposit, err
+	case !found:
+		return nil, deposit, errors.Errorf("no balance of currency id %q in contract account %v for locked deposit, %v",
+			amount.Currency(), contract, amount.Big())
+	default:
+		b, err := currency.StateBalanceValue(st)
+		if err != nil {
+			return nil, deposit, err
+		}
+
+		if b.Big().Compare(amount.Big()) < 0 {
+			return nil, deposit, errors.Errorf("balance of contract account %v, %v is less than locked deposit, %v",
+				contract, b.Big(), amount.Big())
+		}
+	}
+
+	sts = append(sts, common.NewBaseStateMergeValue(
+		cBalanceKey,
+		currency.NewDeductBalanceStateValue(amount),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return currency.NewBalanceStateValueMerger(height, cBalanceKey, amount.Currency(), st)
+		},
+	))
+
+	if action == types.DepositBurn {
+		sts = append(sts, addBalanceStateMergeValue(ctypes.ZeroAddress(amount.Currency()), amount))
+
+		return sts, deposit.Settled(action), nil
+	}
+
+	refunds := []types.DepositContribution{types.NewDepositContribution(deposit.Depositor(), amount)}
+
+	switch st, found, err := getStateFunc(state.StateKeyDepositContributions(contract, pid)); {
+	case err != nil:
+		return nil, deposit, err
+	case found:
+		contributions, err := state.StateDepositContributionsValue(st)
+		if err != nil {
+			return nil, deposit, err
+		}
+		refunds = contributions
+	}
+
+	for i := range refunds {
+		sts = append(sts, addBalanceStateMergeValue(refunds[i].Contributor(), refunds[i].Amount()))
+	}
+
+	return sts, deposit.Settled(action), nil
+}
+
+// lapseUnfunded lapses the crowdfunded proposal which has not reached the deposit target by the end
+// of the deposit period, and every contribution goes back to its contributor. It returns nothing when
+// the proposal is not pending deposit or the deposit period is not over yet.
+func lapseUnfunded(
+	contract base.Address,
+	pid string,
+	p state.ProposalStateValue,
+	nowTime uint64,
+	height base.Height,
+	factHash util.Hash,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	if p.Status() != types.PendingDeposit {
+		return nil, nil
+	}
+
+	period, _, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.DepositCollection, nowTime)
+	if period == types.PreLifeCycle || period == types.DepositCollection {
+		return nil, nil
+	}
+
+	sts, deposit, err := settleDeposit(contract, pid, p.Deposit(), types.DepositRefund, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyProposal(contract, pid),
+		state.NewProposalStateValue(
+			types.Lapsed,
+			fmt.Sprintf("deposit target not reached in deposit period; deposit-ended(%d)", end),
+			types.NewReasonDetail(types.ReasonDepositPeriodEnded),
+			p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+		),
+	))
+
+	hst, err := statusTransitionStateMergeValues(
+		contract, pid, p.Status(), types.Lapsed, types.ReasonDepositPeriodEnded,
+		height, factHash, getStateFunc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sts, hst...), nil
+}
+
+func addBalanceStateMergeValue(receiver base.Address, amount ctypes.Amount) base.StateMergeValue {
+	key := currency.BalanceStateKey(receiver, amount.Currency())
+
+	return common.NewBaseStateMergeValue(
+		key,
+		currency.NewAddBalanceStateValue(amount),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return currency.NewBalanceStateValueMerger(height, key, amount.Currency(), st)
+		},
+	)
+}
+
+// lockedDepositStateMergeValue locks or unlocks the deposit in the locked deposits of the contract account.
+func lockedDepositStateMergeValue(contract base.Address, value base.StateValue, cid ctypes.CurrencyID) base.StateMergeValue {
+	key := state.StateKeyLockedDeposit(contract, cid)
+
+	return common.NewBaseStateMergeValue(
+		key,
+		value,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return state.NewLockedDepositStateValueMerger(height, key, cid, st)
+		},
+	)
+}
+
+// lockedDeposit returns the total of the locked deposits of the currency in the contract account.
+func lockedDeposit(contract base.Address, cid ctypes.CurrencyID, getStateFunc base.GetStateFunc) (common.Big, error) {
+	switch st, found, err := getStateFunc(state.StateKeyLockedDeposit(contract, cid)); {
+	case err != nil:
+		return common.ZeroBig, err
+	case !found:
+		return common.ZeroBig, nil
+	default:
+		am, err := state.StateLockedDepositValue(st)
+		if err != nil {
+			return common.ZeroBig, err
+		}
+
+		return am.Big(), nil
+	}
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/types"
+)
+
+func TestSettleDepositBurnsToZeroAccount(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	proposer := d.newAccount("proposer", 0)
+
+	d.setBalance(0, 5)
+
+	sts, deposit, err := settleDeposit(
+		d.contract, "1", types.NewDeposit(proposer.Address(), d.amount(5), types.DepositLocked),
+		types.DepositBurn, d.tp.GetStateFunc,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deposit.IsLocked() {
+		t.Fatal("expected the deposit settled")
+	}
+
+	d.merge(t, sts)
+
+	if locked := d.lockedDeposit(t); !locked.IsZero() {
+		t.Fatalf("expected the deposit unlocked, got %v", locked)
+	}
+
+	if b := d.balance(t, d.contract); !b.IsZero() {
+		t.Fatalf("expected the deposit deducted from the contract account, got %v", b)
+	}
+
+	if b := d.balance(t, ctypes.ZeroAddress(d.tp.GenesisCurrency)); !b.Equal(common.NewBig(5)) {
+		t.Fatalf("expected the deposit burned to the zero account, got %v", b)
+	}
+
+	if b := d.balance(t, proposer.Address()); !b.IsZero() {
+		t.Fatalf("expected nothing refunded to the proposer, got %v", b)
+	}
+}
+
+func TestSettleDepositFailsOnShortBalance(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+	proposer := d.newAccount("proposer", 0)
+
+	d.setBalance(0, 5)
+	d.tp.NewTestBalanceState(d.contract, d.tp.GenesisCurrency, 3, true)
+
+	if _, _, err := settleDeposit(
+		d.contract, "1", types.NewDeposit(proposer.Address(), d.amount(5), types.DepositLocked),
+		types.DepositRefund, d.tp.GetStateFunc,
+	); err == nil {
+		t.Fatal("expected the deposit not settled from the short contract balance")
+	}
+}
@@ -0,0 +1,177 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/operation/processor"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	SponsorFactHint = hint.MustNewHint("mitum-dao-sponsor-operation-fact-v0.0.1")
+	SponsorHint     = hint.MustNewHint("mitum-dao-sponsor-operation-v0.0.1")
+)
+
+type SponsorFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	currency   types.CurrencyID
+}
+
+func NewSponsorFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	currency types.CurrencyID,
+) SponsorFact {
+	bf := base.NewBaseFact(SponsorFactHint, token)
+	fact := SponsorFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SponsorFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SponsorFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SponsorFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SponsorFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if len(fact.proposalID) == 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("empty proposal ID")))
+	}
+
+	if !types.ReValidSpcecialCh.Match([]byte(fact.proposalID)) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(
+				errors.Errorf("proposal ID %v must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.proposalID)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact SponsorFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SponsorFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SponsorFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact SponsorFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact SponsorFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact SponsorFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+func (fact SponsorFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact SponsorFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact SponsorFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact SponsorFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact SponsorFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact SponsorFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[processor.DuplicationTypeDAOContractProposal] = []string{fmt.Sprintf("%s:%s", fact.Contract().String(), fact.ProposalID())}
+
+	return r, nil
+}
+
+type Sponsor struct {
+	extras.ExtendedOperation
+}
+
+func (op Sponsor) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewSponsor(fact SponsorFact) Sponsor {
+	return Sponsor{
+		ExtendedOperation: extras.NewExtendedOperation(SponsorHint, fact),
+	}
+}
@@ -0,0 +1,212 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var sponsorProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SponsorProcessor)
+	},
+}
+
+func (Sponsor) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type SponsorProcessor struct {
+	*base.BaseOperationProcessor
+	proposal *base.ProposalSignFact
+}
+
+func NewSponsorProcessor() ctypes.GetNewProcessorWithProposal {
+	return func(
+		height base.Height,
+		proposal *base.ProposalSignFact,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new SponsorProcessor")
+
+		nopp := sponsorProcessorPool.Get()
+		opp, ok := nopp.(*SponsorProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected SponsorProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposal = proposal
+
+		return opp, nil
+	}
+}
+
+func (opp *SponsorProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(SponsorFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", SponsorFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	} else if _, err := state.StateDesignValue(st); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	st, err := cstate.ExistsState(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if p.Status() != types.Proposed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v is %q, but can only be sponsored at \"proposed\" status",
+					fact.ProposalID(), fact.Contract(), p.Status())), nil
+	}
+
+	switch required, err := requiresSponsorship(
+		fact.Contract(), p.Policy(), p.Proposal().Proposer(), getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("whitelist member %v in contract account %v", p.Proposal().Proposer(), fact.Contract())), nil
+	case !required:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v does not require sponsorship",
+					fact.ProposalID(), fact.Contract())), nil
+	}
+
+	switch whitelisted, err := isWhitelisted(fact.Contract(), p.Policy().Whitelist(), fact.Sender(), getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("whitelist member %v in contract account %v", fact.Sender(), fact.Contract())), nil
+	case !whitelisted:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not whitelisted in contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	sponsors, err := sponsorsOf(fact.Contract(), fact.ProposalID(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("sponsors for proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	for _, v := range sponsors {
+		if v.Sponsor().Equal(fact.Sender()) {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("sender %v already sponsored proposal %q in contract account %v",
+						fact.Sender(), fact.ProposalID(), fact.Contract())), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SponsorProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(SponsorFact)
+
+	st, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s,%v: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	proposal := *opp.proposal
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.ProposalReview, nowTime)
+	if period != types.ProposalReview {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"current time is not within the review period; review-period(%d ~ %d), now(%d)",
+			start, end, nowTime), nil
+	}
+
+	sponsors, err := sponsorsOf(fact.Contract(), fact.ProposalID(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"sponsors value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	sponsors = append(sponsors, types.NewSponsorInfo(fact.Sender(), opp.Height()))
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeySponsors(fact.Contract(), fact.ProposalID()),
+			state.NewSponsorsStateValue(sponsors),
+		),
+	}, nil, nil
+}
+
+func (opp *SponsorProcessor) Close() error {
+	opp.proposal = nil
+	sponsorProcessorPool.Put(opp)
+
+	return nil
+}
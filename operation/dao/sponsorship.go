@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"fmt"
+
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+// sponsorsOf returns the sponsorships collected by the proposal.
+func sponsorsOf(
+	contract base.Address, pid string, getStateFunc base.GetStateFunc,
+) ([]types.SponsorInfo, error) {
+	switch st, found, err := getStateFunc(state.StateKeySponsors(contract, pid)); {
+	case err != nil:
+		return nil, err
+	case found:
+		return state.StateSponsorsValue(st)
+	default:
+		return nil, nil
+	}
+}
+
+// isUnsponsored reports whether the proposal requires sponsorship but has not
+// collected enough sponsors yet.
+func isUnsponsored(
+	contract base.Address, pid string, p state.ProposalStateValue, getStateFunc base.GetStateFunc,
+) (bool, error) {
+	switch required, err := requiresSponsorship(contract, p.Policy(), p.Proposal().Proposer(), getStateFunc); {
+	case err != nil:
+		return false, err
+	case !required:
+		return false, nil
+	}
+
+	sponsors, err := sponsorsOf(contract, pid, getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	return uint64(len(sponsors)) < p.Policy().SponsorsRequired(), nil
+}
+
+// lapseUnsponsored lapses the proposal which requires sponsorship but has not
+// collected enough sponsors during the review period. It returns nothing when
+// the proposal does not need to lapse.
+func lapseUnsponsored(
+	contract base.Address,
+	pid string,
+	p state.ProposalStateValue,
+	height base.Height,
+	factHash util.Hash,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	switch unsponsored, err := isUnsponsored(contract, pid, p, getStateFunc); {
+	case err != nil:
+		return nil, err
+	case !unsponsored:
+		return nil, nil
+	}
+
+	// as a proposal lapsed in the deposit period, every contribution goes back to its contributor.
+	sts, deposit, err := settleDeposit(contract, pid, p.Deposit(), types.DepositRefund, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyProposal(contract, pid),
+		state.NewProposalStateValue(
+			types.Lapsed,
+			fmt.Sprintf("sponsors not enough in review period; required(%d)", p.Policy().SponsorsRequired()),
+			types.NewReasonDetail(types.ReasonSponsorshipMissing),
+			p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+		),
+	))
+
+	hst, err := statusTransitionStateMergeValues(
+		contract, pid, p.Status(), types.Lapsed, types.ReasonSponsorshipMissing,
+		height, factHash, getStateFunc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sts, hst...), nil
+}
@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+// isTerminalStatus reports whether the proposal has ended and its status can not change anymore.
+func isTerminalStatus(status types.ProposalStatus) bool {
+	return status == types.Executed || isFailedDependencyStatus(status)
+}
+
+// isStaleProposal reports whether the active proposal has missed the period in which its status
+// can change, so it waits for an operation which only ends it. A completed proposal without the
+// execution grace period can wait for its execution forever, so it is stale once it is executable,
+// and so is the failed execution which can not be retried anymore.
+func isStaleProposal(
+	contract base.Address, pid string, p state.ProposalStateValue, nowTime uint64, getStateFunc base.GetStateFunc,
+) (bool, error) {
+	var last types.Period
+
+	switch p.Status() {
+	case types.PendingDeposit:
+		last = types.DepositCollection
+	case types.Proposed:
+		last = types.PreSnapshot
+	case types.PreSnapped:
+		last = types.PostSnapshot
+	case types.ExecutionFailed:
+		var attempts int
+
+		switch st, found, err := getStateFunc(state.StateKeyExecutionHistory(contract, pid)); {
+		case err != nil:
+			return false, err
+		case found:
+			results, err := state.StateExecutionHistoryValue(st)
+			if err != nil {
+				return false, err
+			}
+			attempts = len(results)
+		}
+
+		if uint64(attempts) > p.Policy().ExecutionRetries() {
+			return true, nil
+		}
+
+		fallthrough
+	default:
+		last = types.Execute
+		if p.Policy().ExecutionGracePeriod() == 0 {
+			last = types.ExecutionDelay
+		}
+	}
+
+	_, _, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), last, nowTime)
+
+	return end <= int64(nowTime), nil
+}
+
+// statusTransitionStateMergeValues appends the status transition of the proposal to its status history
+// and keeps the proposal in the active proposals of the dao until it ends.
+func statusTransitionStateMergeValues(
+	contract base.Address,
+	pid string,
+	from, to types.ProposalStatus,
+	reason types.ReasonCode,
+	height base.Height,
+	factHash util.Hash,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var transitions []types.StatusTransition
+
+	switch st, found, err := getStateFunc(state.StateKeyStatusHistory(contract, pid)); {
+	case err != nil:
+		return nil, err
+	case found:
+		if transitions, err = state.StateStatusHistoryValue(st); err != nil {
+			return nil, err
+		}
+	}
+
+	transitions = append(transitions, types.NewStatusTransition(from, to, height, factHash, reason))
+
+	sts := []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyStatusHistory(contract, pid),
+			state.NewStatusHistoryStateValue(transitions),
+		),
+	}
+
+	var value base.StateValue
+
+	switch {
+	case from == types.NilStatus && !isTerminalStatus(to):
+		value = state.NewAddActiveProposalStateValue(pid)
+	case !isTerminalStatus(from) && isTerminalStatus(to):
+		value = state.NewRemoveActiveProposalStateValue(pid)
+	default:
+		return sts, nil
+	}
+
+	key := state.StateKeyActiveProposals(contract)
+
+	return append(sts, common.NewBaseStateMergeValue(
+		key,
+		value,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return state.NewActiveProposalsStateValueMerger(height, key, st)
+		},
+	)), nil
+}
@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestAddVoterAllowlistProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[AddVoterAllowlist]
+	accounts []base.Address
+}
+
+func NewTestAddVoterAllowlistProcessor(
+	tp *test.TestProcessor,
+) TestAddVoterAllowlistProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[AddVoterAllowlist](tp)
+	return TestAddVoterAllowlistProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestAddVoterAllowlistProcessor) Create() *TestAddVoterAllowlistProcessor {
+	t.Opr, _ = NewAddVoterAllowlistProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) SetAccounts(accounts []test.Account) *TestAddVoterAllowlistProcessor {
+	var adrs []base.Address
+
+	for i := range accounts {
+		adrs = append(adrs, accounts[i].Address())
+	}
+
+	t.accounts = adrs
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) LoadOperation(fileName string,
+) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) Print(fileName string,
+) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestAddVoterAllowlistProcessor {
+	op := NewAddVoterAllowlist(
+		NewAddVoterAllowlistFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.accounts,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) RunPreProcess() *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) RunProcess() *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) IsValid() *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestAddVoterAllowlistProcessor) Decode(fileName string) *TestAddVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
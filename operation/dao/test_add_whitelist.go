@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestAddWhitelistProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[AddWhitelist]
+	accounts []base.Address
+}
+
+func NewTestAddWhitelistProcessor(
+	tp *test.TestProcessor,
+) TestAddWhitelistProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[AddWhitelist](tp)
+	return TestAddWhitelistProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestAddWhitelistProcessor) Create() *TestAddWhitelistProcessor {
+	t.Opr, _ = NewAddWhitelistProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) SetAccounts(accounts []test.Account) *TestAddWhitelistProcessor {
+	var adrs []base.Address
+
+	for i := range accounts {
+		adrs = append(adrs, accounts[i].Address())
+	}
+
+	t.accounts = adrs
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) LoadOperation(fileName string,
+) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) Print(fileName string,
+) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestAddWhitelistProcessor {
+	op := NewAddWhitelist(
+		NewAddWhitelistFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.accounts,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) RunPreProcess() *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) RunProcess() *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) IsValid() *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestAddWhitelistProcessor) Decode(fileName string) *TestAddWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
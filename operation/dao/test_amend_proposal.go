@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestAmendProposalProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[AmendProposal]
+}
+
+func NewTestAmendProposalProcessor(
+	tp *test.TestProcessor,
+) TestAmendProposalProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[AmendProposal](tp)
+	return TestAmendProposalProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestAmendProposalProcessor) Create(bm []base.BlockMap) *TestAmendProposalProcessor {
+	t.Opr, _ = NewAmendProposalProcessor()(
+		base.GenesisHeight,
+		blockProposal(bm),
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestAmendProposalProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) SetBlockMap(
+	proposedAt int64, target []base.BlockMap,
+) *TestAmendProposalProcessor {
+	bm := BlockMap{
+		manifest: Manifest{proposedAt: time.Unix(proposedAt, 0)},
+	}
+	test.UpdateSlice[base.BlockMap](bm, target)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) SetProposal(
+	proposer base.Address, startTime uint64, url, hash string, options uint8, target []daotypes.Proposal,
+) *TestAmendProposalProcessor {
+	pr := daotypes.NewBizProposal(proposer, startTime, daotypes.URL(url), hash, options, nil)
+	test.UpdateSlice[daotypes.Proposal](pr, target)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) LoadOperation(fileName string,
+) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) Print(fileName string,
+) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, proposalID string,
+	proposal daotypes.Proposal, currency types.CurrencyID,
+) *TestAmendProposalProcessor {
+	op := NewAmendProposal(
+		NewAmendProposalFact(
+			[]byte("token"),
+			sender,
+			contract,
+			proposalID,
+			proposal,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) RunPreProcess() *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) RunProcess() *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) IsValid() *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestAmendProposalProcessor) Decode(fileName string) *TestAmendProposalProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
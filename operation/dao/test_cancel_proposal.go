@@ -26,6 +26,37 @@ func (m Manifest) ProposedAt() time.Time {
 	return m.proposedAt
 }
 
+type ProposalSignFact struct {
+	base.ProposalSignFact
+	fact ProposalFact
+}
+
+func (p ProposalSignFact) ProposalFact() base.ProposalFact {
+	return p.fact
+}
+
+type ProposalFact struct {
+	base.ProposalFact
+	proposedAt time.Time
+}
+
+func (f ProposalFact) ProposedAt() time.Time {
+	return f.proposedAt
+}
+
+// blockProposal returns the block proposal proposed at the time of the first block map.
+func blockProposal(bm []base.BlockMap) *base.ProposalSignFact {
+	if len(bm) < 1 || bm[0] == nil {
+		return nil
+	}
+
+	var pr base.ProposalSignFact = ProposalSignFact{
+		fact: ProposalFact{proposedAt: bm[0].Manifest().ProposedAt()},
+	}
+
+	return &pr
+}
+
 type TestCancelProposalProcessor struct {
 	*test.BaseTestOperationProcessorNoItem[CancelProposal]
 }
@@ -40,7 +71,7 @@ func NewTestCancelProposalProcessor(
 func (t *TestCancelProposalProcessor) Create(bm []base.BlockMap) *TestCancelProposalProcessor {
 	t.Opr, _ = NewCancelProposalProcessor()(
 		base.GenesisHeight,
-		nil,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
@@ -25,13 +25,33 @@ type TestCreateDAOProcessor struct {
 	executionDelayPeriod uint64
 	turnout              daotypes.PercentRatio
 	quorum               daotypes.PercentRatio
+	depositRule          daotypes.DepositRule
+	depositPeriod        uint64
+	depositTarget        common.Big
+	guardians            daotypes.Guardians
+	objectionThreshold   daotypes.PercentRatio
+	executionGracePeriod uint64
+	executionRetries     uint64
+	sponsorsRequired     uint64
+	reviewers            daotypes.Reviewers
+	voterAllowlistActive bool
+	periodUnit           daotypes.PeriodUnit
+	minLeadTime          uint64
+	maxLeadTime          uint64
+	maxActiveProposals   uint64
+	maxProposerProposals uint64
+	executors            daotypes.Executors
+	bounds               daotypes.PolicyBounds
 }
 
 func NewTestCreateDAOProcessor(
 	tp *test.TestProcessor,
 ) TestCreateDAOProcessor {
 	t := test.NewBaseTestOperationProcessorNoItem[RegisterModel](tp)
-	return TestCreateDAOProcessor{BaseTestOperationProcessorNoItem: &t}
+	return TestCreateDAOProcessor{
+		BaseTestOperationProcessorNoItem: &t,
+		bounds:                           daotypes.NewUnboundedPolicyBounds(),
+	}
 }
 
 func (t *TestCreateDAOProcessor) Create() *TestCreateDAOProcessor {
@@ -165,6 +185,23 @@ func (t *TestCreateDAOProcessor) MakeOperation(
 			t.executionDelayPeriod,
 			t.turnout,
 			t.quorum,
+			t.depositRule,
+			t.depositPeriod,
+			t.depositTarget,
+			t.guardians,
+			t.objectionThreshold,
+			t.executionGracePeriod,
+			t.executionRetries,
+			t.sponsorsRequired,
+			t.reviewers,
+			t.voterAllowlistActive,
+			t.periodUnit,
+			t.minLeadTime,
+			t.maxLeadTime,
+			t.maxActiveProposals,
+			t.maxProposerProposals,
+			t.executors,
+			t.bounds,
 			currency,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
@@ -196,3 +233,96 @@ func (t *TestCreateDAOProcessor) Decode(fileName string) *TestCreateDAOProcessor
 
 	return t
 }
+
+func (t *TestCreateDAOProcessor) SetDepositRule(
+	onCompleted, onRejected, onCanceled, onWithdrawn daotypes.DepositAction,
+) *TestCreateDAOProcessor {
+	t.depositRule = daotypes.NewDepositRule(onCompleted, onRejected, onCanceled, onWithdrawn)
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetCrowdfund(depositPeriod uint64, depositTarget common.Big) *TestCreateDAOProcessor {
+	t.depositPeriod = depositPeriod
+	t.depositTarget = depositTarget
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetGuardians(
+	accounts []base.Address, threshold uint, transferLimit common.Big,
+) *TestCreateDAOProcessor {
+	t.guardians = daotypes.NewGuardians(accounts, threshold, transferLimit)
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetObjectionThreshold(objectionThreshold uint) *TestCreateDAOProcessor {
+	t.objectionThreshold = daotypes.PercentRatio(objectionThreshold)
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetExecutionGracePeriod(executionGracePeriod uint64) *TestCreateDAOProcessor {
+	t.executionGracePeriod = executionGracePeriod
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetExecutionRetries(executionRetries uint64) *TestCreateDAOProcessor {
+	t.executionRetries = executionRetries
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetSponsorsRequired(sponsorsRequired uint64) *TestCreateDAOProcessor {
+	t.sponsorsRequired = sponsorsRequired
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetReviewers(accounts []base.Address) *TestCreateDAOProcessor {
+	t.reviewers = daotypes.NewReviewers(accounts)
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetVoterAllowlistActive(voterAllowlistActive bool) *TestCreateDAOProcessor {
+	t.voterAllowlistActive = voterAllowlistActive
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetPeriodUnit(periodUnit daotypes.PeriodUnit) *TestCreateDAOProcessor {
+	t.periodUnit = periodUnit
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetLeadTime(minLeadTime, maxLeadTime uint64) *TestCreateDAOProcessor {
+	t.minLeadTime = minLeadTime
+	t.maxLeadTime = maxLeadTime
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetActiveProposalLimits(
+	maxActiveProposals, maxProposerProposals uint64,
+) *TestCreateDAOProcessor {
+	t.maxActiveProposals = maxActiveProposals
+	t.maxProposerProposals = maxProposerProposals
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetExecutors(accounts []base.Address) *TestCreateDAOProcessor {
+	t.executors = daotypes.NewExecutors(accounts)
+
+	return t
+}
+
+func (t *TestCreateDAOProcessor) SetBounds(bounds daotypes.PolicyBounds) *TestCreateDAOProcessor {
+	t.bounds = bounds
+
+	return t
+}
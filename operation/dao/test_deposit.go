@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestDepositProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[Deposit]
+}
+
+func NewTestDepositProcessor(
+	tp *test.TestProcessor,
+) TestDepositProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[Deposit](tp)
+	return TestDepositProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestDepositProcessor) Create(bm []base.BlockMap) *TestDepositProcessor {
+	t.Opr, _ = NewDepositProcessor()(
+		base.GenesisHeight,
+		blockProposal(bm),
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestDepositProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestDepositProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestDepositProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestDepositProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestDepositProcessor) SetBlockMap(
+	proposedAt int64, target []base.BlockMap,
+) *TestDepositProcessor {
+	bm := BlockMap{
+		manifest: Manifest{proposedAt: time.Unix(proposedAt, 0)},
+	}
+	test.UpdateSlice[base.BlockMap](bm, target)
+
+	return t
+}
+
+func (t *TestDepositProcessor) LoadOperation(fileName string,
+) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestDepositProcessor) Print(fileName string,
+) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestDepositProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, proposalID string, amount types.Amount, currency types.CurrencyID,
+) *TestDepositProcessor {
+	op := NewDeposit(
+		NewDepositFact(
+			[]byte("token"),
+			sender,
+			contract,
+			proposalID,
+			amount,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestDepositProcessor) RunPreProcess() *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestDepositProcessor) RunProcess() *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestDepositProcessor) IsValid() *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestDepositProcessor) Decode(fileName string) *TestDepositProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -22,7 +22,7 @@ func NewTestExecuteProcessor(
 func (t *TestExecuteProcessor) Create(bm []base.BlockMap) *TestExecuteProcessor {
 	t.Opr, _ = NewExecuteProcessor()(
 		base.GenesisHeight,
-		nil,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
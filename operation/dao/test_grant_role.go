@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestGrantRoleProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[GrantRole]
+	account base.Address
+	role    daotypes.Role
+}
+
+func NewTestGrantRoleProcessor(
+	tp *test.TestProcessor,
+) TestGrantRoleProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[GrantRole](tp)
+	return TestGrantRoleProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestGrantRoleProcessor) Create() *TestGrantRoleProcessor {
+	t.Opr, _ = NewGrantRoleProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetRole(account test.Account, role daotypes.Role) *TestGrantRoleProcessor {
+	t.account = account.Address()
+	t.role = role
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) LoadOperation(fileName string,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) Print(fileName string,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestGrantRoleProcessor {
+	op := NewGrantRole(
+		NewGrantRoleFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.account,
+			t.role,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) RunPreProcess() *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) RunProcess() *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) IsValid() *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) Decode(fileName string) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
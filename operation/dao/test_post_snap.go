@@ -22,7 +22,7 @@ func NewTestPostSnapProcessor(
 func (t *TestPostSnapProcessor) Create(bm []base.BlockMap) *TestPostSnapProcessor {
 	t.Opr, _ = NewPostSnapProcessor()(
 		base.GenesisHeight,
-		nil,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
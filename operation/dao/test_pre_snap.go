@@ -22,7 +22,7 @@ func NewTestPreSnapProcessor(
 func (t *TestPreSnapProcessor) Create(bm []base.BlockMap) *TestPreSnapProcessor {
 	t.Opr, _ = NewPreSnapProcessor()(
 		base.GenesisHeight,
-		nil,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
@@ -20,9 +20,10 @@ func NewTestProposeProcessor(
 	return TestProposeProcessor{BaseTestOperationProcessorNoItem: &t}
 }
 
-func (t *TestProposeProcessor) Create() *TestProposeProcessor {
+func (t *TestProposeProcessor) Create(bm []base.BlockMap) *TestProposeProcessor {
 	t.Opr, _ = NewProposeProcessor()(
 		base.GenesisHeight,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
@@ -64,7 +65,7 @@ func (t *TestProposeProcessor) SetAccount(
 func (t *TestProposeProcessor) SetProposal(
 	proposer base.Address, startTime uint64, url, hash string, options uint8, target []daotypes.Proposal,
 ) *TestProposeProcessor {
-	pr := daotypes.NewBizProposal(proposer, startTime, daotypes.URL(url), hash, options)
+	pr := daotypes.NewBizProposal(proposer, startTime, daotypes.URL(url), hash, options, nil)
 	test.UpdateSlice[daotypes.Proposal](pr, target)
 
 	return t
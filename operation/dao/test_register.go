@@ -22,7 +22,7 @@ func NewTestRegisterProcessor(
 func (t *TestRegisterProcessor) Create(bm []base.BlockMap) *TestRegisterProcessor {
 	t.Opr, _ = NewRegisterProcessor()(
 		base.GenesisHeight,
-		nil,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestRejectProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[Reject]
+}
+
+func NewTestRejectProcessor(
+	tp *test.TestProcessor,
+) TestRejectProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[Reject](tp)
+	return TestRejectProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRejectProcessor) Create(bm []base.BlockMap) *TestRejectProcessor {
+	t.Opr, _ = NewRejectProcessor()(
+		base.GenesisHeight,
+		blockProposal(bm),
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRejectProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRejectProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRejectProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRejectProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRejectProcessor) SetBlockMap(
+	proposedAt int64, target []base.BlockMap,
+) *TestRejectProcessor {
+	bm := BlockMap{
+		manifest: Manifest{proposedAt: time.Unix(proposedAt, 0)},
+	}
+	test.UpdateSlice[base.BlockMap](bm, target)
+
+	return t
+}
+
+func (t *TestRejectProcessor) LoadOperation(fileName string,
+) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRejectProcessor) Print(fileName string,
+) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRejectProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, proposalID, reason string, currency types.CurrencyID,
+) *TestRejectProcessor {
+	op := NewReject(
+		NewRejectFact(
+			[]byte("token"),
+			sender,
+			contract,
+			proposalID,
+			reason,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRejectProcessor) RunPreProcess() *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRejectProcessor) RunProcess() *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRejectProcessor) IsValid() *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRejectProcessor) Decode(fileName string) *TestRejectProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
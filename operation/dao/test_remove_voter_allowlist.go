@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestRemoveVoterAllowlistProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RemoveVoterAllowlist]
+	accounts []base.Address
+}
+
+func NewTestRemoveVoterAllowlistProcessor(
+	tp *test.TestProcessor,
+) TestRemoveVoterAllowlistProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RemoveVoterAllowlist](tp)
+	return TestRemoveVoterAllowlistProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) Create() *TestRemoveVoterAllowlistProcessor {
+	t.Opr, _ = NewRemoveVoterAllowlistProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) SetAccounts(accounts []test.Account) *TestRemoveVoterAllowlistProcessor {
+	var adrs []base.Address
+
+	for i := range accounts {
+		adrs = append(adrs, accounts[i].Address())
+	}
+
+	t.accounts = adrs
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) LoadOperation(fileName string,
+) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) Print(fileName string,
+) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestRemoveVoterAllowlistProcessor {
+	op := NewRemoveVoterAllowlist(
+		NewRemoveVoterAllowlistFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.accounts,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) RunPreProcess() *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) RunProcess() *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) IsValid() *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRemoveVoterAllowlistProcessor) Decode(fileName string) *TestRemoveVoterAllowlistProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
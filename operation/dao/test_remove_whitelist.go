@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestRemoveWhitelistProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RemoveWhitelist]
+	accounts []base.Address
+}
+
+func NewTestRemoveWhitelistProcessor(
+	tp *test.TestProcessor,
+) TestRemoveWhitelistProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RemoveWhitelist](tp)
+	return TestRemoveWhitelistProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRemoveWhitelistProcessor) Create() *TestRemoveWhitelistProcessor {
+	t.Opr, _ = NewRemoveWhitelistProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) SetAccounts(accounts []test.Account) *TestRemoveWhitelistProcessor {
+	var adrs []base.Address
+
+	for i := range accounts {
+		adrs = append(adrs, accounts[i].Address())
+	}
+
+	t.accounts = adrs
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) LoadOperation(fileName string,
+) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) Print(fileName string,
+) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestRemoveWhitelistProcessor {
+	op := NewRemoveWhitelist(
+		NewRemoveWhitelistFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.accounts,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) RunPreProcess() *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) RunProcess() *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) IsValid() *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRemoveWhitelistProcessor) Decode(fileName string) *TestRemoveWhitelistProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestRenounceAdminProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RenounceAdmin]
+}
+
+func NewTestRenounceAdminProcessor(
+	tp *test.TestProcessor,
+) TestRenounceAdminProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RenounceAdmin](tp)
+	return TestRenounceAdminProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRenounceAdminProcessor) Create() *TestRenounceAdminProcessor {
+	t.Opr, _ = NewRenounceAdminProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) LoadOperation(fileName string,
+) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) Print(fileName string,
+) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestRenounceAdminProcessor {
+	op := NewRenounceAdmin(
+		NewRenounceAdminFact(
+			[]byte("token"),
+			sender,
+			contract,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) RunPreProcess() *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) RunProcess() *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) IsValid() *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRenounceAdminProcessor) Decode(fileName string) *TestRenounceAdminProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
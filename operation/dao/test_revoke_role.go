@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	daotypes "github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestRevokeRoleProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RevokeRole]
+	account base.Address
+	role    daotypes.Role
+}
+
+func NewTestRevokeRoleProcessor(
+	tp *test.TestProcessor,
+) TestRevokeRoleProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RevokeRole](tp)
+	return TestRevokeRoleProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRevokeRoleProcessor) Create() *TestRevokeRoleProcessor {
+	t.Opr, _ = NewRevokeRoleProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetRole(account test.Account, role daotypes.Role) *TestRevokeRoleProcessor {
+	t.account = account.Address()
+	t.role = role
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) LoadOperation(fileName string,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) Print(fileName string,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey,
+	contract base.Address, currency types.CurrencyID,
+) *TestRevokeRoleProcessor {
+	op := NewRevokeRole(
+		NewRevokeRoleFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.account,
+			t.role,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) RunPreProcess() *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) RunProcess() *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) IsValid() *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) Decode(fileName string) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
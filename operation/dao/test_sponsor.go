@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestSponsorProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[Sponsor]
+}
+
+func NewTestSponsorProcessor(
+	tp *test.TestProcessor,
+) TestSponsorProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[Sponsor](tp)
+	return TestSponsorProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestSponsorProcessor) Create(bm []base.BlockMap) *TestSponsorProcessor {
+	t.Opr, _ = NewSponsorProcessor()(
+		base.GenesisHeight,
+		blockProposal(bm),
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestSponsorProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) SetBlockMap(
+	proposedAt int64, target []base.BlockMap,
+) *TestSponsorProcessor {
+	bm := BlockMap{
+		manifest: Manifest{proposedAt: time.Unix(proposedAt, 0)},
+	}
+	test.UpdateSlice[base.BlockMap](bm, target)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) LoadOperation(fileName string,
+) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) Print(fileName string,
+) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestSponsorProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, proposalID string, currency types.CurrencyID,
+) *TestSponsorProcessor {
+	op := NewSponsor(
+		NewSponsorFact(
+			[]byte("token"),
+			sender,
+			contract,
+			proposalID,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestSponsorProcessor) RunPreProcess() *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestSponsorProcessor) RunProcess() *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestSponsorProcessor) IsValid() *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestSponsorProcessor) Decode(fileName string) *TestSponsorProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -13,18 +13,33 @@ import (
 type TestUpdatePolicyProcessor struct {
 	*test.BaseTestOperationProcessorNoItem[UpdateModelConfig]
 	option               daotypes.DAOOption
-	votingPowerToken     types.CurrencyID
-	threshold            common.Big
-	fee                  types.Amount
-	whitelist            daotypes.Whitelist
-	proposalReviewPeriod uint64
-	registrationPeriod   uint64
-	preSnapshotPeriod    uint64
-	votingPeriod         uint64
-	postSnapshotPeriod   uint64
-	executionDelayPeriod uint64
-	turnout              daotypes.PercentRatio
-	quorum               daotypes.PercentRatio
+	votingPowerToken     *types.CurrencyID
+	threshold            *common.Big
+	fee                  *types.Amount
+	whitelist            *daotypes.Whitelist
+	proposalReviewPeriod *uint64
+	registrationPeriod   *uint64
+	preSnapshotPeriod    *uint64
+	votingPeriod         *uint64
+	postSnapshotPeriod   *uint64
+	executionDelayPeriod *uint64
+	turnout              *daotypes.PercentRatio
+	quorum               *daotypes.PercentRatio
+	depositRule          *daotypes.DepositRule
+	depositPeriod        *uint64
+	depositTarget        *common.Big
+	guardians            *daotypes.Guardians
+	objectionThreshold   *daotypes.PercentRatio
+	executionGracePeriod *uint64
+	executionRetries     *uint64
+	sponsorsRequired     *uint64
+	reviewers            *daotypes.Reviewers
+	voterAllowlistActive *bool
+	minLeadTime          *uint64
+	maxLeadTime          *uint64
+	maxActiveProposals   *uint64
+	maxProposerProposals *uint64
+	executors            *daotypes.Executors
 }
 
 func NewTestUpdatePolicyProcessor(
@@ -78,7 +93,7 @@ func (t *TestUpdatePolicyProcessor) SetAccount(
 func (t *TestUpdatePolicyProcessor) SetProposal(
 	proposer base.Address, startTime uint64, url, hash string, options uint8, target []daotypes.Proposal,
 ) *TestUpdatePolicyProcessor {
-	pr := daotypes.NewBizProposal(proposer, startTime, daotypes.URL(url), hash, options)
+	pr := daotypes.NewBizProposal(proposer, startTime, daotypes.URL(url), hash, options, nil)
 	test.UpdateSlice[daotypes.Proposal](pr, target)
 
 	return t
@@ -103,7 +118,8 @@ func (t *TestUpdatePolicyProcessor) SetWhitelist(whitelist []test.Account, activ
 		adrs = append(adrs, whitelist[i].Address())
 	}
 
-	t.whitelist = daotypes.NewWhitelist(active, adrs)
+	wl := daotypes.NewWhitelist(active, adrs)
+	t.whitelist = &wl
 
 	return t
 }
@@ -123,17 +139,17 @@ func (t *TestUpdatePolicyProcessor) SetDAO(
 	quorum daotypes.PercentRatio,
 ) *TestUpdatePolicyProcessor {
 	t.option = option
-	t.votingPowerToken = votingPowerToken
-	t.threshold = threshold
-	t.fee = fee
-	t.proposalReviewPeriod = proposalReviewPeriod
-	t.registrationPeriod = registrationPeriod
-	t.preSnapshotPeriod = preSnapshotPeriod
-	t.votingPeriod = votingPeriod
-	t.postSnapshotPeriod = postSnapshotPeriod
-	t.executionDelayPeriod = executionDelayPeriod
-	t.turnout = turnout
-	t.quorum = quorum
+	t.votingPowerToken = &votingPowerToken
+	t.threshold = &threshold
+	t.fee = &fee
+	t.proposalReviewPeriod = &proposalReviewPeriod
+	t.registrationPeriod = &registrationPeriod
+	t.preSnapshotPeriod = &preSnapshotPeriod
+	t.votingPeriod = &votingPeriod
+	t.postSnapshotPeriod = &postSnapshotPeriod
+	t.executionDelayPeriod = &executionDelayPeriod
+	t.turnout = &turnout
+	t.quorum = &quorum
 
 	return t
 }
@@ -156,24 +172,43 @@ func (t *TestUpdatePolicyProcessor) MakeOperation(
 	sender base.Address, privatekey base.Privatekey,
 	contract base.Address, currency types.CurrencyID,
 ) *TestUpdatePolicyProcessor {
+	patch := daotypes.NewPolicyPatch(
+		t.votingPowerToken,
+		t.threshold,
+		t.fee,
+		t.whitelist,
+		t.proposalReviewPeriod,
+		t.registrationPeriod,
+		t.preSnapshotPeriod,
+		t.votingPeriod,
+		t.postSnapshotPeriod,
+		t.executionDelayPeriod,
+		t.turnout,
+		t.quorum,
+		t.depositRule,
+		t.depositPeriod,
+		t.depositTarget,
+		t.guardians,
+		t.objectionThreshold,
+		t.executionGracePeriod,
+		t.executionRetries,
+		t.sponsorsRequired,
+		t.reviewers,
+		t.voterAllowlistActive,
+		t.minLeadTime,
+		t.maxLeadTime,
+		t.maxActiveProposals,
+		t.maxProposerProposals,
+		t.executors,
+	)
+
 	op := NewUpdateModelConfig(
 		NewUpdateModelConfigFact(
 			[]byte("token"),
 			sender,
 			contract,
 			t.option,
-			t.votingPowerToken,
-			t.threshold,
-			t.fee,
-			t.whitelist,
-			t.proposalReviewPeriod,
-			t.registrationPeriod,
-			t.preSnapshotPeriod,
-			t.votingPeriod,
-			t.postSnapshotPeriod,
-			t.executionDelayPeriod,
-			t.turnout,
-			t.quorum,
+			patch,
 			currency,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
@@ -205,3 +240,89 @@ func (t *TestUpdatePolicyProcessor) Decode(fileName string) *TestUpdatePolicyPro
 
 	return t
 }
+
+func (t *TestUpdatePolicyProcessor) SetDepositRule(
+	onCompleted, onRejected, onCanceled, onWithdrawn daotypes.DepositAction,
+) *TestUpdatePolicyProcessor {
+	depositRule := daotypes.NewDepositRule(onCompleted, onRejected, onCanceled, onWithdrawn)
+	t.depositRule = &depositRule
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetCrowdfund(depositPeriod uint64, depositTarget common.Big) *TestUpdatePolicyProcessor {
+	t.depositPeriod = &depositPeriod
+	t.depositTarget = &depositTarget
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetGuardians(
+	accounts []base.Address, threshold uint, transferLimit common.Big,
+) *TestUpdatePolicyProcessor {
+	guardians := daotypes.NewGuardians(accounts, threshold, transferLimit)
+	t.guardians = &guardians
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetObjectionThreshold(objectionThreshold uint) *TestUpdatePolicyProcessor {
+	ratio := daotypes.PercentRatio(objectionThreshold)
+	t.objectionThreshold = &ratio
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetExecutionGracePeriod(executionGracePeriod uint64) *TestUpdatePolicyProcessor {
+	t.executionGracePeriod = &executionGracePeriod
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetExecutionRetries(executionRetries uint64) *TestUpdatePolicyProcessor {
+	t.executionRetries = &executionRetries
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetSponsorsRequired(sponsorsRequired uint64) *TestUpdatePolicyProcessor {
+	t.sponsorsRequired = &sponsorsRequired
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetReviewers(accounts []base.Address) *TestUpdatePolicyProcessor {
+	reviewers := daotypes.NewReviewers(accounts)
+	t.reviewers = &reviewers
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetVoterAllowlistActive(voterAllowlistActive bool) *TestUpdatePolicyProcessor {
+	t.voterAllowlistActive = &voterAllowlistActive
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetLeadTime(minLeadTime, maxLeadTime uint64) *TestUpdatePolicyProcessor {
+	t.minLeadTime = &minLeadTime
+	t.maxLeadTime = &maxLeadTime
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetActiveProposalLimits(
+	maxActiveProposals, maxProposerProposals uint64,
+) *TestUpdatePolicyProcessor {
+	t.maxActiveProposals = &maxActiveProposals
+	t.maxProposerProposals = &maxProposerProposals
+
+	return t
+}
+
+func (t *TestUpdatePolicyProcessor) SetExecutors(accounts []base.Address) *TestUpdatePolicyProcessor {
+	executors := daotypes.NewExecutors(accounts)
+	t.executors = &executors
+
+	return t
+}
@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type TestVetoProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[Veto]
+}
+
+func NewTestVetoProcessor(
+	tp *test.TestProcessor,
+) TestVetoProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[Veto](tp)
+	return TestVetoProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestVetoProcessor) Create(bm []base.BlockMap) *TestVetoProcessor {
+	t.Opr, _ = NewVetoProcessor()(
+		base.GenesisHeight,
+		blockProposal(bm),
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestVetoProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestVetoProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestVetoProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestVetoProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestVetoProcessor) SetBlockMap(
+	proposedAt int64, target []base.BlockMap,
+) *TestVetoProcessor {
+	bm := BlockMap{
+		manifest: Manifest{proposedAt: time.Unix(proposedAt, 0)},
+	}
+	test.UpdateSlice[base.BlockMap](bm, target)
+
+	return t
+}
+
+func (t *TestVetoProcessor) LoadOperation(fileName string,
+) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestVetoProcessor) Print(fileName string,
+) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestVetoProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, proposalID, reason string, currency types.CurrencyID,
+) *TestVetoProcessor {
+	op := NewVeto(
+		NewVetoFact(
+			[]byte("token"),
+			sender,
+			contract,
+			proposalID,
+			reason,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestVetoProcessor) RunPreProcess() *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestVetoProcessor) RunProcess() *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestVetoProcessor) IsValid() *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestVetoProcessor) Decode(fileName string) *TestVetoProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -22,7 +22,7 @@ func NewTestVoteProcessor(
 func (t *TestVoteProcessor) Create(bm []base.BlockMap) *TestVoteProcessor {
 	t.Opr, _ = NewVoteProcessor()(
 		base.GenesisHeight,
-		nil,
+		blockProposal(bm),
 		t.GetStateFunc,
 		nil, nil,
 	)
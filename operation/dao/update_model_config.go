@@ -19,22 +19,11 @@ var (
 
 type UpdateModelConfigFact struct {
 	base.BaseFact
-	sender               base.Address
-	contract             base.Address
-	option               types.DAOOption
-	votingPowerToken     ctypes.CurrencyID
-	threshold            common.Big
-	proposalFee          ctypes.Amount
-	proposerWhitelist    types.Whitelist
-	proposalReviewPeriod uint64
-	registrationPeriod   uint64
-	preSnapshotPeriod    uint64
-	votingPeriod         uint64
-	postSnapshotPeriod   uint64
-	executionDelayPeriod uint64
-	turnout              types.PercentRatio
-	quorum               types.PercentRatio
-	currency             ctypes.CurrencyID
+	sender   base.Address
+	contract base.Address
+	option   types.DAOOption
+	patch    types.PolicyPatch
+	currency ctypes.CurrencyID
 }
 
 func NewUpdateModelConfigFact(
@@ -42,38 +31,17 @@ func NewUpdateModelConfigFact(
 	sender base.Address,
 	contract base.Address,
 	option types.DAOOption,
-	votingPowerToken ctypes.CurrencyID,
-	threshold common.Big,
-	fee ctypes.Amount,
-	whitelist types.Whitelist,
-	proposalReviewPeriod,
-	registrationPeriod,
-	preSnapshotPeriod,
-	votingPeriod,
-	postSnapshotPeriod,
-	executionDelayPeriod uint64,
-	turnout, quorum types.PercentRatio,
+	patch types.PolicyPatch,
 	currency ctypes.CurrencyID,
 ) UpdateModelConfigFact {
 	bf := base.NewBaseFact(UpdateModelConfigFactHint, token)
 	fact := UpdateModelConfigFact{
-		BaseFact:             bf,
-		sender:               sender,
-		contract:             contract,
-		option:               option,
-		votingPowerToken:     votingPowerToken,
-		threshold:            threshold,
-		proposalFee:          fee,
-		proposerWhitelist:    whitelist,
-		proposalReviewPeriod: proposalReviewPeriod,
-		registrationPeriod:   registrationPeriod,
-		preSnapshotPeriod:    preSnapshotPeriod,
-		votingPeriod:         votingPeriod,
-		executionDelayPeriod: executionDelayPeriod,
-		postSnapshotPeriod:   postSnapshotPeriod,
-		turnout:              turnout,
-		quorum:               quorum,
-		currency:             currency,
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		option:   option,
+		patch:    patch,
+		currency: currency,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -94,18 +62,7 @@ func (fact UpdateModelConfigFact) Bytes() []byte {
 		fact.sender.Bytes(),
 		fact.contract.Bytes(),
 		fact.option.Bytes(),
-		fact.votingPowerToken.Bytes(),
-		fact.threshold.Bytes(),
-		fact.proposalFee.Bytes(),
-		fact.proposerWhitelist.Bytes(),
-		util.Uint64ToBytes(fact.proposalReviewPeriod),
-		util.Uint64ToBytes(fact.registrationPeriod),
-		util.Uint64ToBytes(fact.preSnapshotPeriod),
-		util.Uint64ToBytes(fact.votingPeriod),
-		util.Uint64ToBytes(fact.postSnapshotPeriod),
-		util.Uint64ToBytes(fact.executionDelayPeriod),
-		fact.turnout.Bytes(),
-		fact.quorum.Bytes(),
+		fact.patch.Bytes(),
 		fact.currency.Bytes(),
 	)
 }
@@ -118,63 +75,34 @@ func (fact UpdateModelConfigFact) IsValid(b []byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		fact.sender,
 		fact.contract,
-		fact.votingPowerToken,
-		fact.option,
-		fact.proposalFee,
-		fact.threshold,
-		fact.proposerWhitelist,
-		fact.turnout,
-		fact.quorum,
+		fact.patch,
 		fact.currency,
 	); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
 
-	if fact.sender.Equal(fact.contract) {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrSelfTarget.Wrap(
-				errors.Errorf("sender %v is same with contract account", fact.sender)))
-	}
-
-	for i := range fact.proposerWhitelist.Accounts() {
-		if fact.proposerWhitelist.Accounts()[i].Equal(fact.contract) {
-			return common.ErrFactInvalid.Wrap(
-				common.ErrSelfTarget.Wrap(errors.Errorf("whitelist account %v is same with contract account", fact.proposerWhitelist.Accounts()[i])))
+	if len(fact.option) > 0 {
+		if err := fact.option.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
 		}
-	}
-
-	if !fact.proposalFee.Big().OverNil() {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrValOOR.Wrap(errors.Errorf("fee amount must be bigger than or equal to zero, got %v", fact.proposalFee.Big())))
-	}
-
-	if !fact.threshold.OverZero() {
+	} else if fact.patch.IsEmpty() {
 		return common.ErrFactInvalid.Wrap(
-			common.ErrValOOR.Wrap(errors.Errorf("threshold must be bigger than zero, got %v", fact.threshold)))
+			common.ErrValueInvalid.Wrap(errors.Errorf("nothing to update, empty option and policy patch")))
 	}
 
-	if fact.registrationPeriod == 0 {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrValOOR.Wrap(
-				errors.Errorf("registrationPeriod must be bigger than zero, got %v", fact.registrationPeriod)))
-	}
-
-	if fact.preSnapshotPeriod == 0 {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrValOOR.Wrap(
-				errors.Errorf("registrationPeriod must be bigger than zero, got %v", fact.preSnapshotPeriod)))
-	}
-
-	if fact.votingPeriod == 0 {
+	if fact.sender.Equal(fact.contract) {
 		return common.ErrFactInvalid.Wrap(
-			common.ErrValOOR.Wrap(
-				errors.Errorf("registrationPeriod must be bigger than zero, got %v", fact.votingPeriod)))
+			common.ErrSelfTarget.Wrap(
+				errors.Errorf("sender %v is same with contract account", fact.sender)))
 	}
 
-	if fact.postSnapshotPeriod == 0 {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrValOOR.Wrap(
-				errors.Errorf("registrationPeriod must be bigger than zero, got %v", fact.postSnapshotPeriod)))
+	if wl := fact.patch.Whitelist(); wl != nil {
+		for _, ac := range wl.Accounts() {
+			if ac.Equal(fact.contract) {
+				return common.ErrFactInvalid.Wrap(
+					common.ErrSelfTarget.Wrap(errors.Errorf("whitelist account %v is same with contract account", ac)))
+			}
+		}
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -196,56 +124,13 @@ func (fact UpdateModelConfigFact) Contract() base.Address {
 	return fact.contract
 }
 
+// Option is empty to keep the option of the current design.
 func (fact UpdateModelConfigFact) Option() types.DAOOption {
 	return fact.option
 }
 
-func (fact UpdateModelConfigFact) VotingPowerToken() ctypes.CurrencyID {
-	return fact.votingPowerToken
-}
-
-func (fact UpdateModelConfigFact) ProposalFee() ctypes.Amount {
-	return fact.proposalFee
-}
-
-func (fact UpdateModelConfigFact) Threshold() common.Big {
-	return fact.threshold
-}
-
-func (fact UpdateModelConfigFact) Whitelist() types.Whitelist {
-	return fact.proposerWhitelist
-}
-
-func (fact UpdateModelConfigFact) ProposalReviewPeriod() uint64 {
-	return fact.proposalReviewPeriod
-}
-
-func (fact UpdateModelConfigFact) RegistrationPeriod() uint64 {
-	return fact.registrationPeriod
-}
-
-func (fact UpdateModelConfigFact) PreSnapshotPeriod() uint64 {
-	return fact.preSnapshotPeriod
-}
-
-func (fact UpdateModelConfigFact) VotingPeriod() uint64 {
-	return fact.votingPeriod
-}
-
-func (fact UpdateModelConfigFact) PostSnapshotPeriod() uint64 {
-	return fact.postSnapshotPeriod
-}
-
-func (fact UpdateModelConfigFact) ExecutionDelayPeriod() uint64 {
-	return fact.executionDelayPeriod
-}
-
-func (fact UpdateModelConfigFact) Turnout() types.PercentRatio {
-	return fact.turnout
-}
-
-func (fact UpdateModelConfigFact) Quorum() types.PercentRatio {
-	return fact.quorum
+func (fact UpdateModelConfigFact) Patch() types.PolicyPatch {
+	return fact.patch
 }
 
 func (fact UpdateModelConfigFact) Currency() ctypes.CurrencyID {
@@ -253,13 +138,10 @@ func (fact UpdateModelConfigFact) Currency() ctypes.CurrencyID {
 }
 
 func (fact UpdateModelConfigFact) Addresses() ([]base.Address, error) {
-	as := make([]base.Address, 2+len(fact.proposerWhitelist.Accounts()))
-
-	as[0] = fact.sender
-	as[1] = fact.contract
+	as := []base.Address{fact.sender, fact.contract}
 
-	for i, ac := range fact.proposerWhitelist.Accounts() {
-		as[i+2] = ac
+	if wl := fact.patch.Whitelist(); wl != nil {
+		as = append(as, wl.Accounts()...)
 	}
 
 	return as, nil
@@ -281,8 +163,8 @@ func (fact UpdateModelConfigFact) Signer() base.Address {
 	return fact.sender
 }
 
-func (fact UpdateModelConfigFact) ActiveContractOwnerHandlerOnly() [][2]base.Address {
-	return [][2]base.Address{{fact.contract, fact.sender}}
+func (fact UpdateModelConfigFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
 }
 
 func (fact UpdateModelConfigFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
@@ -12,34 +12,26 @@ import (
 func (fact UpdateModelConfigFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":                  fact.Hint().String(),
-			"sender":                 fact.sender,
-			"contract":               fact.contract,
-			"option":                 fact.option,
-			"voting_power_token":     fact.votingPowerToken,
-			"threshold":              fact.threshold,
-			"proposal_fee":           fact.proposalFee,
-			"proposer_whitelist":     fact.proposerWhitelist,
-			"proposal_review_period": fact.proposalReviewPeriod,
-			"registration_period":    fact.registrationPeriod,
-			"pre_snapshot_period":    fact.preSnapshotPeriod,
-			"voting_period":          fact.votingPeriod,
-			"post_snapshot_period":   fact.postSnapshotPeriod,
-			"execution_delay_period": fact.executionDelayPeriod,
-			"turnout":                fact.turnout,
-			"quorum":                 fact.quorum,
-			"currency":               fact.currency,
-			"hash":                   fact.BaseFact.Hash().String(),
-			"token":                  fact.BaseFact.Token(),
+			"_hint":    fact.Hint().String(),
+			"sender":   fact.sender,
+			"contract": fact.contract,
+			"option":   fact.option,
+			"patch":    fact.patch,
+			"currency": fact.currency,
+			"hash":     fact.BaseFact.Hash().String(),
+			"token":    fact.BaseFact.Token(),
 		},
 	)
 }
 
 type UpdateModelConfigFactBSONUnmarshaler struct {
-	Hint                 string   `bson:"_hint"`
-	Sender               string   `bson:"sender"`
-	Contract             string   `bson:"contract"`
-	Option               string   `bson:"option"`
+	Hint     string   `bson:"_hint"`
+	Sender   string   `bson:"sender"`
+	Contract string   `bson:"contract"`
+	Option   string   `bson:"option"`
+	Patch    bson.Raw `bson:"patch"`
+	Currency string   `bson:"currency"`
+	// the policy fields of the facts written before policy patches
 	VotingPowerToken     string   `bson:"voting_power_token"`
 	Threshold            string   `bson:"threshold"`
 	ProposalFee          bson.Raw `bson:"proposal_fee"`
@@ -52,7 +44,6 @@ type UpdateModelConfigFactBSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64   `bson:"execution_delay_period"`
 	Turnout              uint     `bson:"turnout"`
 	Quorum               uint     `bson:"quorum"`
-	Currency             string   `bson:"currency"`
 }
 
 func (fact *UpdateModelConfigFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -79,6 +70,7 @@ func (fact *UpdateModelConfigFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) er
 		uf.Sender,
 		uf.Contract,
 		uf.Option,
+		uf.Patch,
 		uf.VotingPowerToken,
 		uf.Threshold,
 		uf.ProposalFee,
@@ -10,7 +10,9 @@ import (
 )
 
 func (fact *UpdateModelConfigFact) unpack(enc encoder.Encoder,
-	sa, ca, op, tk, th string,
+	sa, ca, op string,
+	bpp []byte,
+	tk, th string,
 	bf, bw []byte,
 	prp, rp, prsp, vp, psp, edp uint64,
 	to, qou uint,
@@ -18,21 +20,6 @@ func (fact *UpdateModelConfigFact) unpack(enc encoder.Encoder,
 ) error {
 	fact.currency = ctypes.CurrencyID(cid)
 	fact.option = types.DAOOption(op)
-	fact.votingPowerToken = ctypes.CurrencyID(tk)
-	fact.proposalReviewPeriod = prp
-	fact.registrationPeriod = rp
-	fact.preSnapshotPeriod = prsp
-	fact.votingPeriod = vp
-	fact.postSnapshotPeriod = psp
-	fact.executionDelayPeriod = edp
-	fact.turnout = types.PercentRatio(to)
-	fact.quorum = types.PercentRatio(qou)
-
-	if big, err := common.NewBigFromString(th); err != nil {
-		return err
-	} else {
-		fact.threshold = big
-	}
 
 	switch a, err := base.DecodeAddress(sa, enc); {
 	case err != nil:
@@ -48,21 +35,63 @@ func (fact *UpdateModelConfigFact) unpack(enc encoder.Encoder,
 		fact.contract = a
 	}
 
-	if hinter, err := enc.Decode(bf); err != nil {
+	switch hinter, err := enc.Decode(bpp); {
+	case err != nil:
 		return err
+	case hinter == nil:
+		// the facts written before policy patches replace these policy fields as a whole.
+		pp, err := legacyPolicyPatch(enc, tk, th, bf, bw, prp, rp, prsp, vp, psp, edp, to, qou)
+		if err != nil {
+			return err
+		}
+		fact.patch = pp
+	default:
+		if pp, ok := hinter.(types.PolicyPatch); !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected PolicyPatch, not %T", hinter))
+		} else {
+			fact.patch = pp
+		}
+	}
+
+	return nil
+}
+
+func legacyPolicyPatch(enc encoder.Encoder,
+	tk, th string,
+	bf, bw []byte,
+	prp, rp, prsp, vp, psp, edp uint64,
+	to, qou uint,
+) (types.PolicyPatch, error) {
+	token := ctypes.CurrencyID(tk)
+	turnout, quorum := types.PercentRatio(to), types.PercentRatio(qou)
+
+	threshold, err := common.NewBigFromString(th)
+	if err != nil {
+		return types.PolicyPatch{}, err
+	}
+
+	var fee ctypes.Amount
+	if hinter, err := enc.Decode(bf); err != nil {
+		return types.PolicyPatch{}, err
 	} else if am, ok := hinter.(ctypes.Amount); !ok {
-		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+		return types.PolicyPatch{}, common.ErrTypeMismatch.Wrap(errors.Errorf("expected Amount, not %T", hinter))
 	} else {
-		fact.proposalFee = am
+		fee = am
 	}
 
+	var whitelist types.Whitelist
 	if hinter, err := enc.Decode(bw); err != nil {
-		return err
+		return types.PolicyPatch{}, err
 	} else if wl, ok := hinter.(types.Whitelist); !ok {
-		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Whitelist, not %T", hinter))
+		return types.PolicyPatch{}, common.ErrTypeMismatch.Wrap(errors.Errorf("expected Whitelist, not %T", hinter))
 	} else {
-		fact.proposerWhitelist = wl
+		whitelist = wl
 	}
 
-	return nil
+	return types.NewPolicyPatch(
+		&token, &threshold, &fee, &whitelist,
+		&prp, &rp, &prsp, &vp, &psp, &edp,
+		&turnout, &quorum,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+	), nil
 }
@@ -14,22 +14,11 @@ import (
 
 type UpdateModelConfigFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Owner                base.Address       `json:"sender"`
-	Contract             base.Address       `json:"contract"`
-	Option               types.DAOOption    `json:"option"`
-	VotingPowerToken     ctypes.CurrencyID  `json:"voting_power_token"`
-	Threshold            common.Big         `json:"threshold"`
-	ProposalFee          ctypes.Amount      `json:"proposal_fee"`
-	ProposerWhitelist    types.Whitelist    `json:"proposer_whitelist"`
-	ProposalReviewPeriod uint64             `json:"proposal_review_period"`
-	RegistrationPeriod   uint64             `json:"registration_period"`
-	PreSnapshotPeriod    uint64             `json:"pre_snapshot_period"`
-	VotingPeriod         uint64             `json:"voting_period"`
-	PostSnapshotPeriod   uint64             `json:"post_snapshot_period"`
-	ExecutionDelayPeriod uint64             `json:"execution_delay_period"`
-	Turnout              types.PercentRatio `json:"turnout"`
-	Quorum               types.PercentRatio `json:"quorum"`
-	Currency             ctypes.CurrencyID  `json:"currency"`
+	Owner    base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	Option   types.DAOOption   `json:"option"`
+	Patch    types.PolicyPatch `json:"patch"`
+	Currency ctypes.CurrencyID `json:"currency"`
 }
 
 func (fact UpdateModelConfigFact) MarshalJSON() ([]byte, error) {
@@ -38,27 +27,19 @@ func (fact UpdateModelConfigFact) MarshalJSON() ([]byte, error) {
 		Owner:                 fact.sender,
 		Contract:              fact.contract,
 		Option:                fact.option,
-		VotingPowerToken:      fact.votingPowerToken,
-		Threshold:             fact.threshold,
-		ProposalFee:           fact.proposalFee,
-		ProposerWhitelist:     fact.proposerWhitelist,
-		ProposalReviewPeriod:  fact.proposalReviewPeriod,
-		RegistrationPeriod:    fact.registrationPeriod,
-		PreSnapshotPeriod:     fact.preSnapshotPeriod,
-		VotingPeriod:          fact.votingPeriod,
-		PostSnapshotPeriod:    fact.postSnapshotPeriod,
-		ExecutionDelayPeriod:  fact.executionDelayPeriod,
-		Turnout:               fact.turnout,
-		Quorum:                fact.quorum,
+		Patch:                 fact.patch,
 		Currency:              fact.currency,
 	})
 }
 
 type UpdateModelConfigFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner                string          `json:"sender"`
-	Contract             string          `json:"contract"`
-	Option               string          `json:"option"`
+	Owner    string          `json:"sender"`
+	Contract string          `json:"contract"`
+	Option   string          `json:"option"`
+	Patch    json.RawMessage `json:"patch"`
+	Currency string          `json:"currency"`
+	// the policy fields of the facts written before policy patches
 	VotingPowerToken     string          `json:"voting_power_token"`
 	Threshold            string          `json:"threshold"`
 	ProposalFee          json.RawMessage `json:"proposal_fee"`
@@ -71,7 +52,6 @@ type UpdateModelConfigFactJSONUnMarshaler struct {
 	ExecutionDelayPeriod uint64          `json:"execution_delay_period"`
 	Turnout              uint            `json:"turnout"`
 	Quorum               uint            `json:"quorum"`
-	Currency             string          `json:"currency"`
 }
 
 func (fact *UpdateModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -81,10 +61,12 @@ func (fact *UpdateModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) err
 	}
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
 	if err := fact.unpack(enc,
 		uf.Owner,
 		uf.Contract,
 		uf.Option,
+		uf.Patch,
 		uf.VotingPowerToken,
 		uf.Threshold,
 		uf.ProposalFee,
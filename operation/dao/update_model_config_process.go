@@ -80,39 +80,88 @@ func (opp *UpdateModelConfigProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
 	}
 
-	whitelist := fact.Whitelist().Accounts()
-	for _, white := range whitelist {
-		if _, _, _, cErr := cstate.ExistsCAccount(white, "whitelist", true, false, getStateFunc); cErr != nil {
-			return ctx, base.NewBaseOperationProcessReasonError(
-				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
-					Errorf("%v: whitelist %v is contract account", cErr, white)), nil
-		}
+	st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
 	}
 
-	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+	design, err := state.StateDesignValue(st)
+	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
 				fact.Contract(),
 			)), nil
-	} else if _, err := state.StateDesignValue(st); err != nil {
+	}
+
+	switch renounced, err := state.StateAdminRenouncedValue(st); {
+	case err != nil:
 		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
 				fact.Contract(),
 			)), nil
+	case renounced:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMAccountNAth).Errorf(
+				"admin renounced for dao contract %v, policy changes only by governance", fact.Contract(),
+			)), nil
 	}
 
-	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.VotingPowerToken()), getStateFunc); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+	if err := checkRole(fact.Contract(), fact.Sender(), types.RoleAdmin, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	if fact.Patch().Executors() != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
-				Wrap(common.ErrMCurrencyNF).Errorf("voting power token %q", fact.VotingPowerToken())), nil
+				Wrap(common.ErrMAccountNAth).Errorf(
+				"executors of dao contract %v change only by governance", fact.Contract(),
+			)), nil
+	}
+
+	if wl := fact.Patch().Whitelist(); wl != nil {
+		for _, white := range wl.Accounts() {
+			if _, _, _, cErr := cstate.ExistsCAccount(white, "whitelist", true, false, getStateFunc); cErr != nil {
+				return ctx, base.NewBaseOperationProcessReasonError(
+					common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+						Errorf("%v: whitelist %v is contract account", cErr, white)), nil
+			}
+		}
+	}
+
+	if token := fact.Patch().VotingPowerToken(); token != nil {
+		if err := cstate.CheckExistsState(currency.DesignStateKey(*token), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMCurrencyNF).Errorf("voting power token %q", *token)), nil
+		}
 	}
 
-	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.proposalFee.Currency()), getStateFunc); err != nil {
+	if fee := fact.Patch().ProposalFee(); fee != nil {
+		if err := cstate.CheckExistsState(currency.DesignStateKey(fee.Currency()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("proposal fee currency %q", fee.Currency())), nil
+		}
+	}
+
+	policy := fact.Patch().Apply(design.Policy())
+	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
-				Errorf("proposal fee currency %q", fact.proposalFee.Currency())), nil
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("patched policy of dao contract %v: %v", fact.Contract(), err)), nil
+	}
+
+	if err := design.Bounds().Check(policy); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("patched policy of dao contract %v out of policy bounds: %v", fact.Contract(), err)), nil
 	}
 
 	return ctx, nil, nil
@@ -129,35 +178,48 @@ func (opp *UpdateModelConfigProcessor) Process(
 		return nil, nil, e.Errorf("expected UpdatePolicyFact, not %T", op.Fact())
 	}
 
-	policy := types.NewPolicy(
-		fact.votingPowerToken, fact.threshold, fact.proposalFee, fact.proposerWhitelist,
-		fact.proposalReviewPeriod, fact.registrationPeriod, fact.preSnapshotPeriod, fact.votingPeriod,
-		fact.postSnapshotPeriod, fact.executionDelayPeriod, fact.turnout, fact.quorum,
-	)
+	st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	dv, err := state.StateDesignStateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s; %w", fact.Contract(), err), nil
+	}
+	current := dv.Design()
+
+	policy := fact.Patch().Apply(current.Policy())
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid dao policy, %s: %w", fact.Contract(), err), nil
 	}
 
-	design := types.NewDesign(fact.option, policy)
+	option := current.Option()
+	if len(fact.Option()) > 0 {
+		option = fact.Option()
+	}
+
+	design := types.NewDesign(option, policy, current.Bounds())
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid design, %s: %w", fact.Contract(), err), nil
 	}
 
 	var sts []base.StateMergeValue
 
-	whitelist := fact.Whitelist().Accounts()
-	for _, white := range whitelist {
-		smv, err := cstate.CreateNotExistAccount(white, getStateFunc)
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
-		} else if smv != nil {
-			sts = append(sts, smv)
+	if wl := fact.Patch().Whitelist(); wl != nil {
+		for _, white := range wl.Accounts() {
+			smv, err := cstate.CreateNotExistAccount(white, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			} else if smv != nil {
+				sts = append(sts, smv)
+			}
 		}
 	}
 
 	sts = append(sts, cstate.NewStateMergeValue(
 		state.StateKeyDesign(fact.Contract()),
-		state.NewDesignStateValue(design),
+		state.NewDesignStateValue(design, dv.AdminRenounced(), dv.PolicyVersion()+1, opp.Height(), fact.Hash()),
 	))
 
 	return sts, nil, nil
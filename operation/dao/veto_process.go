@@ -0,0 +1,270 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var vetoProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(VetoProcessor)
+	},
+}
+
+func (Veto) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type VetoProcessor struct {
+	*base.BaseOperationProcessor
+	proposal *base.ProposalSignFact
+}
+
+func NewVetoProcessor() ctypes.GetNewProcessorWithProposal {
+	return func(
+		height base.Height,
+		proposal *base.ProposalSignFact,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new VetoProcessor")
+
+		nopp := vetoProcessorPool.Get()
+		opp, ok := nopp.(*VetoProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected VetoProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposal = proposal
+
+		return opp, nil
+	}
+}
+
+func (opp *VetoProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(VetoFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", VetoFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := cstate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if st, err := cstate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	} else if _, err := state.StateDesignValue(st); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).Errorf("dao service state for contract account %v",
+				fact.Contract(),
+			)), nil
+	}
+
+	st, err := cstate.ExistsState(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposal %q for contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	guardians := p.Policy().Guardians()
+
+	// a sender out of the policy guardians needs the guardian role.
+	if !guardians.IsExist(fact.Sender()) {
+		if err := checkRole(fact.Contract(), fact.Sender(), types.RoleGuardian, getStateFunc); err != nil {
+			return ctx, err, nil
+		}
+	}
+
+	if !guardians.Guards(p.Proposal()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v is not security-critical; neither governance nor large transfer",
+					fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if p.Status() == types.Vetoed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("already vetoed proposal %q in contract account %v",
+					fact.ProposalID(), fact.Contract())), nil
+	} else if p.Status() != types.Completed {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %q in contract account %v is %q, but can only be vetoed at \"completed\" status",
+					fact.ProposalID(), fact.Contract(), p.Status())), nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyVetoes(fact.Contract(), fact.ProposalID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("vetoes for proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	case found:
+		vetoes, err := state.StateVetoesValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("vetoes for proposal %q in contract account %v", fact.ProposalID(), fact.Contract())), nil
+		}
+
+		for _, v := range vetoes {
+			if v.Guardian().Equal(fact.Sender()) {
+				return nil, base.NewBaseOperationProcessReasonError(
+					"%s", common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+						Errorf("guardian %v already vetoed proposal %q in contract account %v",
+							fact.Sender(), fact.ProposalID(), fact.Contract())), nil
+			}
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *VetoProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(VetoFact)
+
+	st, err := cstate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s,%v: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	proposal := *opp.proposal
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.ExecutionDelay, nowTime)
+	if period != types.ExecutionDelay {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"current time is not within the execution delay period; execution-delay-period(%d ~ %d), now(%d)",
+			start, end, nowTime), nil
+	}
+
+	var vetoes []types.VetoInfo
+
+	switch st, found, err := getStateFunc(state.StateKeyVetoes(fact.Contract(), fact.ProposalID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to get vetoes, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	case found:
+		if vetoes, err = state.StateVetoesValue(st); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"vetoes value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+	}
+
+	veto := types.NewVetoInfo(fact.Sender(), fact.Reason())
+	vetoes = append(vetoes, veto)
+
+	var sts []base.StateMergeValue
+
+	vetoesKey := state.StateKeyVetoes(fact.Contract(), fact.ProposalID())
+	sts = append(sts, common.NewBaseStateMergeValue(
+		vetoesKey,
+		state.NewVetoesStateValue([]types.VetoInfo{veto}),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return state.NewVetoesStateValueMerger(height, vetoesKey, st)
+		},
+	))
+
+	// every recorded veto is of a policy guardian or a guardian role holder, and counts.
+	if uint(len(vetoes)) < p.Policy().Guardians().Threshold() {
+		return sts, nil, nil
+	}
+
+	reasons := make([]string, len(vetoes))
+	for i, v := range vetoes {
+		reasons[i] = fmt.Sprintf("%s: %s", v.Guardian(), v.Reason())
+	}
+
+	dsts, deposit, err := settleDeposit(
+		fact.Contract(), fact.ProposalID(), p.Deposit(), p.Policy().DepositRule().Action(types.Vetoed), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to settle proposal deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+	sts = append(sts, dsts...)
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+		state.NewProposalStateValue(
+			types.Vetoed,
+			fmt.Sprintf("vetoed by guardians; %s", strings.Join(reasons, "; ")),
+			types.NewReasonDetail(types.ReasonVetoed),
+			p.Proposal(), p.Sequence(), p.Amendments(), p.Policy(), p.PolicyVersion(), deposit,
+		),
+	))
+
+	hst, err := statusTransitionStateMergeValues(
+		fact.Contract(), fact.ProposalID(), p.Status(), types.Vetoed, types.ReasonVetoed,
+		opp.Height(), fact.Hash(), getStateFunc,
+	)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to record status transition, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+	sts = append(sts, hst...)
+
+	return sts, nil, nil
+}
+
+func (opp *VetoProcessor) Close() error {
+	opp.proposal = nil
+	vetoProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestVetoCountsGuardianRoleHolders(t *testing.T) {
+	d := newTestDAO(t, testPolicy{})
+
+	g0 := d.newAccount("guardian0", 100)
+	g1 := d.newAccount("guardian1", 100)
+	holder := d.newAccount("holder", 100)
+	outsider := d.newAccount("outsider", 100)
+
+	d.setPolicy(testPolicy{
+		guardians: types.NewGuardians([]base.Address{g0.Address(), g1.Address()}, 2, common.ZeroBig),
+	})
+	d.setState(
+		state.StateKeyRoles(d.contract, holder.Address()),
+		state.NewRolesStateValue(holder.Address(), []types.Role{types.RoleGuardian}),
+	)
+
+	proposer := d.newAccount("proposer", 0)
+	d.setProposal("1", proposer.Address(), types.Completed,
+		types.NewDeposit(proposer.Address(), d.amount(1), types.DepositLocked))
+	d.setBalance(0, 1)
+
+	// the execution delay period of the proposal is from 150 to 160.
+	veto := func(sender test.Account) error {
+		p := NewTestVetoProcessor(d.tp)
+		p.Create(blockMaps(155)).
+			MakeOperation(sender.Address(), sender.Priv(), d.contract, "1", "no", d.tp.GenesisCurrency).
+			RunPreProcess()
+		if err := p.Error(); err != nil {
+			return err
+		}
+
+		return p.RunProcess().Error()
+	}
+
+	if err := veto(outsider); err == nil {
+		t.Fatal("expected the veto of an account without the guardian role rejected")
+	}
+
+	if err := veto(holder); err != nil {
+		t.Fatal(err)
+	}
+
+	if pv := d.proposal(t, "1"); pv.Status() != types.Completed {
+		t.Fatalf("expected completed proposal after one veto, got %v", pv.Status())
+	}
+
+	if err := veto(g0); err != nil {
+		t.Fatal(err)
+	}
+
+	if pv := d.proposal(t, "1"); pv.Status() != types.Vetoed {
+		t.Fatalf("expected vetoed proposal by the guardian role holder and the policy guardian, got %v", pv.Status())
+	}
+
+	st, _, _ := d.tp.GetStateFunc(state.StateKeyVetoes(d.contract, "1"))
+	if vetoes, err := state.StateVetoesValue(st); err != nil {
+		t.Fatal(err)
+	} else if len(vetoes) != 2 {
+		t.Fatalf("expected 2 vetoes recorded, got %d", len(vetoes))
+	}
+}
@@ -129,6 +129,18 @@ func (opp *VoteProcessor) PreProcess(
 				Errorf("proposal %q in contract account %v is not in pre-snapped status, got %v", fact.ProposalID(), fact.Contract(), p.Status())), nil
 	}
 
+	// a voter removed from the voter allowlist after registration can not vote.
+	switch eligible, err := isEligibleVoter(fact.Contract(), p.Policy(), fact.Sender(), getStateFunc); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("voter allowlist member %v in contract account %v", fact.Sender(), fact.Contract())), nil
+	case !eligible:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"%s", common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender not in voter allowlist, %s", fact.Sender())), nil
+	}
+
 	switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
 	case err != nil:
 		return nil, base.NewBaseOperationProcessReasonError(
@@ -209,7 +221,7 @@ func (opp *VoteProcessor) Process(
 	}
 
 	proposal := *opp.proposal
-	nowTime := uint64(proposal.ProposalFact().ProposedAt().Unix())
+	nowTime := p.Policy().PeriodNow(proposal.ProposalFact().ProposedAt(), opp.Height())
 
 	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Voting, nowTime)
 	if period != types.Voting {
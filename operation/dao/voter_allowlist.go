@@ -0,0 +1,55 @@
+package dao
+
+import (
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// isVoterAllowlisted reports whether the account is an active voter allowlist
+// member of the dao.
+func isVoterAllowlisted(contract base.Address, account base.Address, getStateFunc base.GetStateFunc) (bool, error) {
+	switch st, found, err := getStateFunc(state.StateKeyVoterAllowlistMember(contract, account)); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		vm, err := state.StateVoterAllowlistMemberValue(st)
+		if err != nil {
+			return false, err
+		}
+
+		return vm.Active(), nil
+	}
+}
+
+// isEligibleVoter reports whether the account can register and vote under the
+// policy. Every account is eligible unless the voter allowlist is active.
+func isEligibleVoter(
+	contract base.Address, policy types.Policy, account base.Address, getStateFunc base.GetStateFunc,
+) (bool, error) {
+	if !policy.VoterAllowlistActive() {
+		return true, nil
+	}
+
+	return isVoterAllowlisted(contract, account, getStateFunc)
+}
+
+// voterAllowlistMemberStateMergeValues sets the accounts as active or inactive
+// voter allowlist members of the dao.
+func voterAllowlistMemberStateMergeValues(
+	contract base.Address, accounts []base.Address, active bool,
+) []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, len(accounts))
+
+	for i, a := range accounts {
+		sts[i] = cstate.NewStateMergeValue(
+			state.StateKeyVoterAllowlistMember(contract, a),
+			state.NewVoterAllowlistMemberStateValue(a, active),
+		)
+	}
+
+	return sts
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/dao-model/state"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// isWhitelisted reports whether the account is in the proposer whitelist of the
+// policy, an active whitelist member or a proposer of the dao.
+func isWhitelisted(
+	contract base.Address, whitelist types.Whitelist, account base.Address, getStateFunc base.GetStateFunc,
+) (bool, error) {
+	if whitelist.IsExist(account) {
+		return true, nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyWhitelistMember(contract, account)); {
+	case err != nil:
+		return false, err
+	case found:
+		wm, err := state.StateWhitelistMemberValue(st)
+		if err != nil {
+			return false, err
+		}
+
+		if wm.Active() {
+			return true, nil
+		}
+	}
+
+	return hasRole(contract, account, types.RoleProposer, getStateFunc)
+}
+
+// requiresSponsorship reports whether the proposal of the proposer needs
+// sponsorships of whitelisted accounts to enter the registration period.
+func requiresSponsorship(
+	contract base.Address, policy types.Policy, proposer base.Address, getStateFunc base.GetStateFunc,
+) (bool, error) {
+	if policy.SponsorsRequired() < 1 || !policy.Whitelist().Active() {
+		return false, nil
+	}
+
+	whitelisted, err := isWhitelisted(contract, policy.Whitelist(), proposer, getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	return !whitelisted, nil
+}
+
+// whitelistMemberStateMergeValues sets the accounts as active or inactive
+// whitelist members of the dao.
+func whitelistMemberStateMergeValues(
+	contract base.Address, accounts []base.Address, active bool,
+) []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, len(accounts))
+
+	for i, a := range accounts {
+		sts[i] = cstate.NewStateMergeValue(
+			state.StateKeyWhitelistMember(contract, a),
+			state.NewWhitelistMemberStateValue(a, active),
+		)
+	}
+
+	return sts
+}
@@ -1,9 +1,158 @@
 package processor
 
 import (
+	cprocessor "github.com/imfact-labs/currency-model/operation/processor"
 	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
 )
 
 const (
-	DuplicationTypeDAOContractProposal ctypes.DuplicationKeyType = "dao-contract-proposal"
+	DuplicationTypeDAOContractProposal        ctypes.DuplicationKeyType = "dao-contract-proposal"
+	DuplicationTypeDAOContractProposalCounter ctypes.DuplicationKeyType = "dao-contract-proposal-counter"
+	DuplicationTypeDAOContractWhitelist       ctypes.DuplicationKeyType = "dao-contract-whitelist"
+	DuplicationTypeDAOContractVoterAllowlist  ctypes.DuplicationKeyType = "dao-contract-voter-allowlist"
+	DuplicationTypeDAOContractRole            ctypes.DuplicationKeyType = "dao-contract-role"
+	DuplicationTypeDAOContractTreasury        ctypes.DuplicationKeyType = "dao-contract-treasury"
+	DuplicationTypeDAOContractDeposit         ctypes.DuplicationKeyType = "dao-contract-deposit"
 )
+
+// StateDeDupeKeyer is a fact whose duplication keys depend on the state before the block, like an
+// execute which writes the dao design only for a governance proposal.
+type StateDeDupeKeyer interface {
+	StateDupKey(base.GetStateFunc) (map[ctypes.DuplicationKeyType][]string, error)
+}
+
+// SharedDeDupeKeyer is a fact whose shared duplication keys can be taken by the other facts with
+// the same shared keys within a proposal, but not by the facts which take them as their duplication
+// keys, like the deposits to a proposal which can be in the same block, but not with the cancel of
+// the proposal.
+type SharedDeDupeKeyer interface {
+	SharedDupKey() (map[ctypes.DuplicationKeyType][]string, error)
+}
+
+func sharedDuplicationKey(dk string) string {
+	return dk + ":shared"
+}
+
+// CheckDuplication checks the duplication keys of the StateDeDupeKeyer facts and the shared
+// duplication keys of the SharedDeDupeKeyer facts against the other operations of the block, then
+// the ones of the currency CheckDuplication.
+func CheckDuplication(opr *cprocessor.OperationProcessor, op base.Operation) error {
+	dupKeySet := cprocessor.NewDupKeySet()
+
+	if keyer, ok := op.Fact().(StateDeDupeKeyer); ok && opr.GetStateFunc != nil {
+		dkSet, err := keyer.StateDupKey(opr.GetStateFunc)
+		if err != nil {
+			return err
+		}
+
+		for k, v := range dkSet {
+			for _, dk := range v {
+				dupKeySet.Add(k, dk)
+			}
+		}
+	}
+
+	sharedKeySet := cprocessor.NewDupKeySet()
+
+	if keyer, ok := op.Fact().(SharedDeDupeKeyer); ok {
+		dkSet, err := keyer.SharedDupKey()
+		if err != nil {
+			return err
+		}
+
+		for k, v := range dkSet {
+			for _, dk := range v {
+				sharedKeySet.Add(k, dk)
+			}
+		}
+	}
+
+	// the keys of the fact and the operation are checked by the currency CheckDuplication, but they
+	// are also checked against the shared keys.
+	exclusiveKeySet := cprocessor.NewDupKeySet()
+	for _, i := range []interface{}{op.Fact(), op} {
+		keyer, ok := i.(interface {
+			DupKey() (map[ctypes.DuplicationKeyType][]string, error)
+		})
+		if !ok {
+			continue
+		}
+
+		dkSet, err := keyer.DupKey()
+		if err != nil {
+			return err
+		}
+
+		for k, v := range dkSet {
+			for _, dk := range v {
+				exclusiveKeySet.Add(k, dk)
+			}
+		}
+	}
+
+	opr.RLock()
+	for kType, kSet := range *dupKeySet {
+		for _, dk := range kSet {
+			if _, found := opr.Duplicated[dk]; found {
+				opr.RUnlock()
+
+				return errors.Errorf(
+					"cannot use a duplicated %v for %v within a proposal",
+					dk, kType,
+				)
+			}
+		}
+	}
+
+	for _, set := range []*cprocessor.DupKeySet{dupKeySet, exclusiveKeySet} {
+		for kType, kSet := range *set {
+			for _, dk := range kSet {
+				if _, found := opr.Duplicated[sharedDuplicationKey(dk)]; found {
+					opr.RUnlock()
+
+					return errors.Errorf(
+						"cannot use a shared %v for %v within a proposal",
+						dk, kType,
+					)
+				}
+			}
+		}
+	}
+
+	for kType, kSet := range *sharedKeySet {
+		for _, dk := range kSet {
+			if _, found := opr.Duplicated[dk]; found {
+				opr.RUnlock()
+
+				return errors.Errorf(
+					"cannot share a duplicated %v for %v within a proposal",
+					dk, kType,
+				)
+			}
+		}
+	}
+	opr.RUnlock()
+
+	if err := cprocessor.CheckDuplication(opr, op); err != nil {
+		return err
+	}
+
+	opr.Lock()
+	defer opr.Unlock()
+
+	for _, kSet := range *dupKeySet {
+		for _, dk := range kSet {
+			opr.Duplicated[dk] = struct{}{}
+		}
+	}
+
+	for _, kSet := range *sharedKeySet {
+		for _, dk := range kSet {
+			opr.Duplicated[sharedDuplicationKey(dk)] = struct{}{}
+		}
+	}
+
+	return nil
+}
@@ -11,41 +11,95 @@ var AddedHinters = []encoder.DecodeDetail{
 	// revive:disable-next-line:line-length-limit
 	{Hint: types.BizProposalHint, Instance: types.BizProposal{}},
 	{Hint: types.CryptoProposalHint, Instance: types.CryptoProposal{}},
+	{Hint: types.ActionResultHint, Instance: types.ActionResult{}},
+	{Hint: types.AmendmentHint, Instance: types.Amendment{}},
 	{Hint: types.DelegatorInfoHint, Instance: types.DelegatorInfo{}},
+	{Hint: types.DepositHint, Instance: types.Deposit{}},
+	{Hint: types.DepositContributionHint, Instance: types.DepositContribution{}},
+	{Hint: types.DepositRuleHint, Instance: types.DepositRule{}},
 	{Hint: types.DesignHint, Instance: types.Design{}},
+	{Hint: types.ExecutionResultHint, Instance: types.ExecutionResult{}},
+	{Hint: types.ExecutorsHint, Instance: types.Executors{}},
 	{Hint: types.GovernanceCalldataHint, Instance: types.GovernanceCallData{}},
+	{Hint: types.GuardiansHint, Instance: types.Guardians{}},
 	{Hint: types.PolicyHint, Instance: types.Policy{}},
+	{Hint: types.PolicyBoundsHint, Instance: types.PolicyBounds{}},
+	{Hint: types.PolicyPatchHint, Instance: types.PolicyPatch{}},
+	{Hint: types.ReasonDetailHint, Instance: types.ReasonDetail{}},
+	{Hint: types.ReviewersHint, Instance: types.Reviewers{}},
+	{Hint: types.SponsorInfoHint, Instance: types.SponsorInfo{}},
+	{Hint: types.StatusTransitionHint, Instance: types.StatusTransition{}},
+	{Hint: types.TallyHint, Instance: types.Tally{}},
 	{Hint: types.TransferCalldataHint, Instance: types.TransferCallData{}},
+	{Hint: types.VetoInfoHint, Instance: types.VetoInfo{}},
 	{Hint: types.VoterInfoHint, Instance: types.VoterInfo{}},
 	{Hint: types.VotingPowerHint, Instance: types.VotingPower{}},
 	{Hint: types.VotingPowerBoxHint, Instance: types.VotingPowerBox{}},
 	{Hint: types.WhitelistHint, Instance: types.Whitelist{}},
+	{Hint: types.WhitelistCalldataHint, Instance: types.WhitelistCallData{}},
 
+	{Hint: state.ActiveProposalsStateValueHint, Instance: state.ActiveProposalsStateValue{}},
+	{Hint: state.LockedDepositStateValueHint, Instance: state.LockedDepositStateValue{}},
 	{Hint: state.DelegatorsStateValueHint, Instance: state.DelegatorsStateValue{}},
+	{Hint: state.DepositContributionsStateValueHint, Instance: state.DepositContributionsStateValue{}},
 	{Hint: state.DesignStateValueHint, Instance: state.DesignStateValue{}},
+	{Hint: state.ExecutionHistoryStateValueHint, Instance: state.ExecutionHistoryStateValue{}},
+	{Hint: state.ProposalCounterStateValueHint, Instance: state.ProposalCounterStateValue{}},
 	{Hint: state.ProposalStateValueHint, Instance: state.ProposalStateValue{}},
+	{Hint: state.RoleHoldersStateValueHint, Instance: state.RoleHoldersStateValue{}},
+	{Hint: state.RolesStateValueHint, Instance: state.RolesStateValue{}},
+	{Hint: state.SponsorsStateValueHint, Instance: state.SponsorsStateValue{}},
+	{Hint: state.StatusHistoryStateValueHint, Instance: state.StatusHistoryStateValue{}},
+	{Hint: state.TallyStateValueHint, Instance: state.TallyStateValue{}},
+	{Hint: state.VetoesStateValueHint, Instance: state.VetoesStateValue{}},
+	{Hint: state.VoterAllowlistMemberStateValueHint, Instance: state.VoterAllowlistMemberStateValue{}},
 	{Hint: state.VotersStateValueHint, Instance: state.VotersStateValue{}},
 	{Hint: state.VotingPowerBoxStateValueHint, Instance: state.VotingPowerBoxStateValue{}},
+	{Hint: state.WhitelistMemberStateValueHint, Instance: state.WhitelistMemberStateValue{}},
 
+	{Hint: dao.AddVoterAllowlistHint, Instance: dao.AddVoterAllowlist{}},
+	{Hint: dao.AddWhitelistHint, Instance: dao.AddWhitelist{}},
+	{Hint: dao.AmendProposalHint, Instance: dao.AmendProposal{}},
 	{Hint: dao.CancelProposalHint, Instance: dao.CancelProposal{}},
+	{Hint: dao.DepositHint, Instance: dao.Deposit{}},
 	{Hint: dao.RegisterModelHint, Instance: dao.RegisterModel{}},
 	{Hint: dao.ExecuteHint, Instance: dao.Execute{}},
+	{Hint: dao.GrantRoleHint, Instance: dao.GrantRole{}},
 	{Hint: dao.PostSnapHint, Instance: dao.PostSnap{}},
 	{Hint: dao.PreSnapHint, Instance: dao.PreSnap{}},
 	{Hint: dao.ProposeHint, Instance: dao.Propose{}},
 	{Hint: dao.RegisterHint, Instance: dao.Register{}},
+	{Hint: dao.RejectHint, Instance: dao.Reject{}},
+	{Hint: dao.RemoveVoterAllowlistHint, Instance: dao.RemoveVoterAllowlist{}},
+	{Hint: dao.RemoveWhitelistHint, Instance: dao.RemoveWhitelist{}},
+	{Hint: dao.RenounceAdminHint, Instance: dao.RenounceAdmin{}},
+	{Hint: dao.RevokeRoleHint, Instance: dao.RevokeRole{}},
+	{Hint: dao.SponsorHint, Instance: dao.Sponsor{}},
 	{Hint: dao.UpdateModelConfigHint, Instance: dao.UpdateModelConfig{}},
+	{Hint: dao.VetoHint, Instance: dao.Veto{}},
 	{Hint: dao.VoteHint, Instance: dao.Vote{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
+	{Hint: dao.AddVoterAllowlistFactHint, Instance: dao.AddVoterAllowlistFact{}},
+	{Hint: dao.AddWhitelistFactHint, Instance: dao.AddWhitelistFact{}},
+	{Hint: dao.AmendProposalFactHint, Instance: dao.AmendProposalFact{}},
 	{Hint: dao.CancelProposalFactHint, Instance: dao.CancelProposalFact{}},
+	{Hint: dao.DepositFactHint, Instance: dao.DepositFact{}},
 	{Hint: dao.RegisterModelFactHint, Instance: dao.RegisterModelFact{}},
 	{Hint: dao.ExecuteFactHint, Instance: dao.ExecuteFact{}},
+	{Hint: dao.GrantRoleFactHint, Instance: dao.GrantRoleFact{}},
 	{Hint: dao.PostSnapFactHint, Instance: dao.PostSnapFact{}},
 	{Hint: dao.PreSnapFactHint, Instance: dao.PreSnapFact{}},
 	{Hint: dao.ProposeFactHint, Instance: dao.ProposeFact{}},
 	{Hint: dao.RegisterFactHint, Instance: dao.RegisterFact{}},
+	{Hint: dao.RejectFactHint, Instance: dao.RejectFact{}},
+	{Hint: dao.RemoveVoterAllowlistFactHint, Instance: dao.RemoveVoterAllowlistFact{}},
+	{Hint: dao.RemoveWhitelistFactHint, Instance: dao.RemoveWhitelistFact{}},
+	{Hint: dao.RenounceAdminFactHint, Instance: dao.RenounceAdminFact{}},
+	{Hint: dao.RevokeRoleFactHint, Instance: dao.RevokeRoleFact{}},
+	{Hint: dao.SponsorFactHint, Instance: dao.SponsorFact{}},
 	{Hint: dao.UpdateModelConfigFactHint, Instance: dao.UpdateModelConfigFact{}},
+	{Hint: dao.VetoFactHint, Instance: dao.VetoFact{}},
 	{Hint: dao.VoteFactHint, Instance: dao.VoteFact{}},
 }
@@ -6,6 +6,7 @@ import (
 	cprocessor "github.com/imfact-labs/currency-model/operation/processor"
 	ctype "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/operation/dao"
+	"github.com/imfact-labs/dao-model/operation/processor"
 	"github.com/imfact-labs/dao-model/runtime/contracts"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/isaac"
@@ -44,11 +45,11 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		return pctx, err
 	}
 
-	//err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
-	//if err != nil {
-	//	return pctx, err
-	//}
-	err := opr.SetGetNewProcessorFunc(cprocessor.GetNewProcessor)
+	err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
+	if err != nil {
+		return pctx, err
+	}
+	err = opr.SetGetNewProcessorFunc(cprocessor.GetNewProcessor)
 	if err != nil {
 		return pctx, err
 	}
@@ -56,14 +57,26 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 	processorsA := []processorInfoA{
 		{dao.RegisterModelHint, dao.NewRegisterModelProcessor()},
 		{dao.UpdateModelConfigHint, dao.NewUpdatePolicyProcessor()},
-		{dao.ProposeHint, dao.NewProposeProcessor()},
+		{dao.AddWhitelistHint, dao.NewAddWhitelistProcessor()},
+		{dao.RemoveWhitelistHint, dao.NewRemoveWhitelistProcessor()},
+		{dao.AddVoterAllowlistHint, dao.NewAddVoterAllowlistProcessor()},
+		{dao.RemoveVoterAllowlistHint, dao.NewRemoveVoterAllowlistProcessor()},
+		{dao.GrantRoleHint, dao.NewGrantRoleProcessor()},
+		{dao.RevokeRoleHint, dao.NewRevokeRoleProcessor()},
+		{dao.RenounceAdminHint, dao.NewRenounceAdminProcessor()},
 	}
 	processorsB := []processorInfoB{
+		{dao.ProposeHint, dao.NewProposeProcessor()},
+		{dao.AmendProposalHint, dao.NewAmendProposalProcessor()},
 		{dao.CancelProposalHint, dao.NewCancelProposalProcessor()},
+		{dao.SponsorHint, dao.NewSponsorProcessor()},
+		{dao.RejectHint, dao.NewRejectProcessor()},
+		{dao.DepositHint, dao.NewDepositProcessor()},
 		{dao.RegisterHint, dao.NewRegisterProcessor()},
 		{dao.PreSnapHint, dao.NewPreSnapProcessor()},
 		{dao.VoteHint, dao.NewVoteProcessor()},
 		{dao.PostSnapHint, dao.NewPostSnapProcessor()},
+		{dao.VetoHint, dao.NewVetoProcessor()},
 		{dao.ExecuteHint, dao.NewExecuteProcessor()},
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -21,15 +22,31 @@ func StateKeyDAOPrefix(ca base.Address) string {
 	return fmt.Sprintf("%s:%s", DAOPrefix, ca.String())
 }
 
+// DesignStateValue keeps the design with the policy version. The version increases whenever
+// the policy is changed; the height and the fact hash are of the operation which changed it.
 type DesignStateValue struct {
 	hint.BaseHinter
-	design types.Design
+	design         types.Design
+	adminRenounced bool
+	policyVersion  uint64
+	height         base.Height
+	factHash       util.Hash
 }
 
-func NewDesignStateValue(design types.Design) DesignStateValue {
+func NewDesignStateValue(
+	design types.Design,
+	adminRenounced bool,
+	policyVersion uint64,
+	height base.Height,
+	factHash util.Hash,
+) DesignStateValue {
 	return DesignStateValue{
-		BaseHinter: hint.NewBaseHinter(DesignStateValueHint),
-		design:     design,
+		BaseHinter:     hint.NewBaseHinter(DesignStateValueHint),
+		design:         design,
+		adminRenounced: adminRenounced,
+		policyVersion:  policyVersion,
+		height:         height,
+		factHash:       factHash,
 	}
 }
 
@@ -52,7 +69,47 @@ func (de DesignStateValue) IsValid([]byte) error {
 }
 
 func (de DesignStateValue) HashBytes() []byte {
-	return de.design.Bytes()
+	return util.ConcatBytesSlice(
+		de.design.Bytes(),
+		util.BoolToBytes(de.adminRenounced),
+		util.Uint64ToBytes(de.policyVersion),
+		de.height.Bytes(),
+		de.factHash.Bytes(),
+	)
+}
+
+func (de DesignStateValue) Design() types.Design {
+	return de.design
+}
+
+func (de DesignStateValue) AdminRenounced() bool {
+	return de.adminRenounced
+}
+
+func (de DesignStateValue) PolicyVersion() uint64 {
+	return de.policyVersion
+}
+
+func (de DesignStateValue) Height() base.Height {
+	return de.height
+}
+
+func (de DesignStateValue) FactHash() util.Hash {
+	return de.factHash
+}
+
+func StateDesignStateValue(st base.State) (DesignStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return DesignStateValue{}, util.ErrNotFound.Errorf("dao design not found in State")
+	}
+
+	d, ok := v.(DesignStateValue)
+	if !ok {
+		return DesignStateValue{}, errors.Errorf("invalid dao design value found, %T", v)
+	}
+
+	return d, nil
 }
 
 func StateDesignValue(st base.State) (types.Design, error) {
@@ -69,6 +126,20 @@ func StateDesignValue(st base.State) (types.Design, error) {
 	return d.design, nil
 }
 
+func StateAdminRenouncedValue(st base.State) (bool, error) {
+	v := st.Value()
+	if v == nil {
+		return false, util.ErrNotFound.Errorf("dao design not found in State")
+	}
+
+	d, ok := v.(DesignStateValue)
+	if !ok {
+		return false, errors.Errorf("invalid dao design value found, %T", v)
+	}
+
+	return d.adminRenounced, nil
+}
+
 func IsStateDesignKey(key string) bool {
 	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DesignSuffix)
 }
@@ -84,22 +155,58 @@ var (
 
 type ProposalStateValue struct {
 	hint.BaseHinter
-	status   types.ProposalStatus
-	reason   string
-	proposal types.Proposal
-	policy   types.Policy
+	status        types.ProposalStatus
+	reason        string
+	reasonDetail  types.ReasonDetail
+	proposal      types.Proposal
+	sequence      uint64
+	amendments    []types.Amendment
+	policy        types.Policy
+	policyVersion uint64
+	deposit       types.Deposit
 }
 
-func NewProposalStateValue(status types.ProposalStatus, reason string, proposal types.Proposal, policy types.Policy) ProposalStateValue {
+// NewProposalStateValue creates the proposal state value. The sequence is the
+// number assigned to a proposal proposed without an id; it is zero otherwise.
+// The amendments keep the proposal bodies replaced by AmendProposal. The policy
+// version is the version of the dao policy the proposal was created under.
+func NewProposalStateValue(
+	status types.ProposalStatus,
+	reason string,
+	reasonDetail types.ReasonDetail,
+	proposal types.Proposal,
+	sequence uint64,
+	amendments []types.Amendment,
+	policy types.Policy,
+	policyVersion uint64,
+	deposit types.Deposit,
+) ProposalStateValue {
 	return ProposalStateValue{
-		BaseHinter: hint.NewBaseHinter(ProposalStateValueHint),
-		status:     status,
-		reason:     reason,
-		proposal:   proposal,
-		policy:     policy,
+		BaseHinter:    hint.NewBaseHinter(ProposalStateValueHint),
+		status:        status,
+		reason:        reason,
+		reasonDetail:  reasonDetail,
+		proposal:      proposal,
+		sequence:      sequence,
+		amendments:    amendments,
+		policy:        policy,
+		policyVersion: policyVersion,
+		deposit:       deposit,
 	}
 }
 
+// legacyDeposit is the deposit of the proposals stored before proposal deposits;
+// their proposal fee was paid to the contract account at proposing.
+func legacyDeposit(proposal types.Proposal, policy types.Policy) types.Deposit {
+	return types.NewDeposit(proposal.Proposer(), policy.ProposalFee(), types.DepositKept)
+}
+
+// legacyReasonDetail is the reason detail of the proposals stored before reason details;
+// their reason is only the reason text.
+func legacyReasonDetail() types.ReasonDetail {
+	return types.NewReasonDetail(types.ReasonProposed)
+}
+
 func (p ProposalStateValue) Hint() hint.Hint {
 	return p.BaseHinter.Hint()
 }
@@ -112,14 +219,34 @@ func (p ProposalStateValue) Reason() string {
 	return p.reason
 }
 
+func (p ProposalStateValue) ReasonDetail() types.ReasonDetail {
+	return p.reasonDetail
+}
+
 func (p ProposalStateValue) Proposal() types.Proposal {
 	return p.proposal
 }
 
+func (p ProposalStateValue) Sequence() uint64 {
+	return p.sequence
+}
+
+func (p ProposalStateValue) Amendments() []types.Amendment {
+	return p.amendments
+}
+
 func (p ProposalStateValue) Policy() types.Policy {
 	return p.policy
 }
 
+func (p ProposalStateValue) PolicyVersion() uint64 {
+	return p.policyVersion
+}
+
+func (p ProposalStateValue) Deposit() types.Deposit {
+	return p.deposit
+}
+
 func (p ProposalStateValue) IsValid([]byte) error {
 	e := util.ErrInvalid.Errorf("invalid dao ProposalStateValue")
 
@@ -129,23 +256,44 @@ func (p ProposalStateValue) IsValid([]byte) error {
 
 	if err := util.CheckIsValiders(
 		nil, false,
+		p.reasonDetail,
 		p.proposal,
 		p.policy,
+		p.deposit,
 	); err != nil {
 		return e.Wrap(err)
 	}
 
+	if len(p.amendments) > types.MaxProposalAmendments {
+		return e.Errorf("too many amendments, %d > %d", len(p.amendments), types.MaxProposalAmendments)
+	}
+
+	for _, a := range p.amendments {
+		if err := a.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return nil
 }
 
 func (p ProposalStateValue) HashBytes() []byte {
-	util.ConcatBytesSlice(
+	ab := make([][]byte, len(p.amendments))
+	for i, a := range p.amendments {
+		ab[i] = a.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
 		p.status.Bytes(),
 		[]byte(p.reason),
+		p.reasonDetail.Bytes(),
 		p.proposal.Bytes(),
+		util.Uint64ToBytes(p.sequence),
+		util.ConcatBytesSlice(ab...),
 		p.policy.Bytes(),
+		util.Uint64ToBytes(p.policyVersion),
+		p.deposit.Bytes(),
 	)
-	return p.proposal.Bytes()
 }
 
 func StateProposalValue(st base.State) (ProposalStateValue, error) {
@@ -171,270 +319,1399 @@ func StateKeyProposal(ca base.Address, pid string) string {
 }
 
 var (
-	DelegatorsStateValueHint = hint.MustNewHint("mitum-dao-delegators-state-value-v0.0.1")
-	DelegatorsSuffix         = "delegators"
+	ProposalCounterStateValueHint = hint.MustNewHint("mitum-dao-proposal-counter-state-value-v0.0.1")
+	ProposalCounterSuffix         = "proposal-counter"
 )
 
-type DelegatorsStateValue struct {
+// ProposalCounterStateValue keeps the last sequence number assigned to
+// a proposal proposed without an id in the dao.
+type ProposalCounterStateValue struct {
 	hint.BaseHinter
-	delegators []types.DelegatorInfo
+	sequence uint64
 }
 
-func NewDelegatorsStateValue(delegators []types.DelegatorInfo) DelegatorsStateValue {
-	return DelegatorsStateValue{
-		BaseHinter: hint.NewBaseHinter(DelegatorsStateValueHint),
-		delegators: delegators,
+func NewProposalCounterStateValue(sequence uint64) ProposalCounterStateValue {
+	return ProposalCounterStateValue{
+		BaseHinter: hint.NewBaseHinter(ProposalCounterStateValueHint),
+		sequence:   sequence,
 	}
 }
 
-func (dg DelegatorsStateValue) Hint() hint.Hint {
-	return dg.BaseHinter.Hint()
+func (pc ProposalCounterStateValue) Hint() hint.Hint {
+	return pc.BaseHinter.Hint()
 }
 
-func (dg DelegatorsStateValue) IsValid([]byte) error {
-	e := util.ErrInvalid.Errorf("invalid dao DelegatorsStateValue")
+func (pc ProposalCounterStateValue) Sequence() uint64 {
+	return pc.sequence
+}
 
-	if err := dg.BaseHinter.IsValid(DelegatorsStateValueHint.Type().Bytes()); err != nil {
-		return e.Wrap(err)
-	}
+func (pc ProposalCounterStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao ProposalCounterStateValue")
 
-	for _, ac := range dg.delegators {
-		if err := ac.IsValid(nil); err != nil {
-			return e.Wrap(err)
-		}
+	if err := pc.BaseHinter.IsValid(ProposalCounterStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
 	}
 
 	return nil
 }
 
-func (dg DelegatorsStateValue) HashBytes() []byte {
-	ba := make([][]byte, len(dg.delegators))
-
-	for i, delegator := range dg.delegators {
-		ba[i] = delegator.Bytes()
-	}
-
-	return util.ConcatBytesSlice(ba...)
+func (pc ProposalCounterStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(pc.sequence)
 }
 
-func StateDelegatorsValue(st base.State) ([]types.DelegatorInfo, error) {
+func StateProposalCounterValue(st base.State) (uint64, error) {
 	v := st.Value()
 	if v == nil {
-		return nil, util.ErrNotFound.Errorf("delegators not found in State")
+		return 0, util.ErrNotFound.Errorf("proposal counter not found in State")
 	}
 
-	ap, ok := v.(DelegatorsStateValue)
+	pc, ok := v.(ProposalCounterStateValue)
 	if !ok {
-		return nil, errors.Errorf("invalid delegators value found, %T", v)
+		return 0, errors.Errorf("invalid proposal counter value found, %T", v)
 	}
 
-	return ap.delegators, nil
+	return pc.sequence, nil
 }
 
-func IsStateDelegatorsKey(key string) bool {
-	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DelegatorsSuffix)
+func IsStateProposalCounterKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, ProposalCounterSuffix)
 }
 
-func StateKeyDelegators(ca base.Address, pid string) string {
-	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, DelegatorsSuffix)
+func StateKeyProposalCounter(ca base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyDAOPrefix(ca), ProposalCounterSuffix)
 }
 
 var (
-	VotersStateValueHint = hint.MustNewHint("mitum-dao-voters-state-value-v0.0.1")
-	VotersSuffix         = "voters"
+	ActiveProposalsStateValueHint = hint.MustNewHint("mitum-dao-active-proposals-state-value-v0.0.1")
+	ActiveProposalsSuffix         = "active-proposals"
 )
 
-type VotersStateValue struct {
+// ActiveProposalsStateValue keeps the ids of the proposals of the dao which have not ended yet.
+// It is updated by AddActiveProposalStateValue and RemoveActiveProposalStateValue through
+// ActiveProposalsStateValueMerger as the status of the proposals changes.
+type ActiveProposalsStateValue struct {
 	hint.BaseHinter
-	voters []types.VoterInfo
+	proposalIDs []string
 }
 
-func NewVotersStateValue(voters []types.VoterInfo) VotersStateValue {
-	return VotersStateValue{
-		BaseHinter: hint.NewBaseHinter(VotersStateValueHint),
-		voters:     voters,
+func NewActiveProposalsStateValue(proposalIDs []string) ActiveProposalsStateValue {
+	return ActiveProposalsStateValue{
+		BaseHinter:  hint.NewBaseHinter(ActiveProposalsStateValueHint),
+		proposalIDs: proposalIDs,
 	}
 }
 
-func (vt VotersStateValue) Hint() hint.Hint {
-	return vt.BaseHinter.Hint()
+func (ap ActiveProposalsStateValue) Hint() hint.Hint {
+	return ap.BaseHinter.Hint()
 }
 
-func (vt VotersStateValue) Voters() []types.VoterInfo {
-	return vt.voters
+func (ap ActiveProposalsStateValue) ProposalIDs() []string {
+	return ap.proposalIDs
 }
 
-func (vt VotersStateValue) IsValid([]byte) error {
-	e := util.ErrInvalid.Errorf("invalid dao VotersStateValue")
+func (ap ActiveProposalsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao ActiveProposalsStateValue")
 
-	if err := vt.BaseHinter.IsValid(VotersStateValueHint.Type().Bytes()); err != nil {
+	if err := ap.BaseHinter.IsValid(ActiveProposalsStateValueHint.Type().Bytes()); err != nil {
 		return e.Wrap(err)
 	}
 
 	founds := map[string]struct{}{}
-	for _, info := range vt.voters {
-		if err := info.IsValid(nil); err != nil {
-			return e.Wrap(err)
+	for _, pid := range ap.proposalIDs {
+		if len(pid) == 0 {
+			return e.Wrap(errors.Errorf("empty proposal id"))
 		}
 
-		if _, found := founds[info.Account().String()]; found {
-			return e.Wrap(errors.Errorf("duplicate voter account found, %q", info.Account()))
+		if _, found := founds[pid]; found {
+			return e.Wrap(errors.Errorf("duplicated proposal id, %q", pid))
 		}
+		founds[pid] = struct{}{}
 	}
 
 	return nil
 }
 
-func (vt VotersStateValue) HashBytes() []byte {
-	bs := make([][]byte, len(vt.voters))
-
-	for i, br := range vt.voters {
-		bs[i] = br.Bytes()
+func (ap ActiveProposalsStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(ap.proposalIDs))
+	for i := range ap.proposalIDs {
+		bs[i] = []byte(ap.proposalIDs[i])
 	}
 
 	return util.ConcatBytesSlice(bs...)
 }
 
-func StateVotersValue(st base.State) ([]types.VoterInfo, error) {
+func StateActiveProposalsValue(st base.State) ([]string, error) {
 	v := st.Value()
 	if v == nil {
-		return nil, util.ErrNotFound.Errorf("voters not found in State")
+		return nil, util.ErrNotFound.Errorf("active proposals not found in State")
 	}
 
-	r, ok := v.(VotersStateValue)
+	ap, ok := v.(ActiveProposalsStateValue)
 	if !ok {
-		return nil, errors.Errorf("invalid voters value found, %T", v)
+		return nil, errors.Errorf("invalid active proposals value found, %T", v)
 	}
 
-	return r.voters, nil
+	return ap.proposalIDs, nil
 }
 
-func IsStateVotersKey(key string) bool {
-	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VotersSuffix)
+func IsStateActiveProposalsKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, ActiveProposalsSuffix)
 }
 
-func StateKeyVoters(ca base.Address, pid string) string {
-	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VotersSuffix)
+func StateKeyActiveProposals(ca base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyDAOPrefix(ca), ActiveProposalsSuffix)
 }
 
-//var (
-//	ProposalStatusStateValueHint = hint.MustNewHint("mitum-dao-proposal-status-state-value-v0.0.1")
-//	ProposalStatusSuffix         = ":proposalstatus"
-//)
+// AddActiveProposalStateValue adds the proposal to the active proposals of the dao.
+type AddActiveProposalStateValue struct {
+	ProposalID string
+}
 
-//type ProposalStatusStateValue struct {
-//	hint.BaseHinter
-//	proposalStatus types.ProposalStatus
-//}
-//
-//func NewProposalStatusStateValue(proposalStatus types.ProposalStatus) ProposalStatusStateValue {
-//	return ProposalStatusStateValue{
-//		BaseHinter:     hint.NewBaseHinter(ProposalStatusStateValueHint),
-//		proposalStatus: proposalStatus,
-//	}
-//}
-//
-//func (ps ProposalStatusStateValue) Hint() hint.Hint {
-//	return ps.BaseHinter.Hint()
-//}
-//
-//func (ps ProposalStatusStateValue) ProposalStatus() types.ProposalStatus {
-//	return ps.proposalStatus
-//}
-//
-//func (ps ProposalStatusStateValue) IsValid([]byte) error {
-//	e := util.ErrInvalid.Errorf("invalid ProposalStatusStateValue")
-//
-//	if err := ps.BaseHinter.IsValid(ProposalStatusStateValueHint.Type().Bytes()); err != nil {
-//		return e.Wrap(err)
-//	}
-//
-//	return nil
-//}
-//
-//func (ps ProposalStatusStateValue) HashBytes() []byte {
-//	return ps.proposalStatus.Bytes()
-//}
-//
-//func StateProposalStatusValue(st base.State) (types.ProposalStatus, error) {
-//	v := st.Value()
-//	if v == nil {
-//		return types.NilStatus, util.ErrNotFound.Errorf("ProposalStatus value not found in State")
-//	}
-//
-//	ps, ok := v.(ProposalStatusStateValue)
-//	if !ok {
-//		return types.NilStatus, errors.Errorf("invalid ProposalStatus value found, %T", v)
-//	}
-//
-//	return ps.proposalStatus, nil
-//}
-//
-//func IsStateProposalStatusKey(key string) bool {
-//	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, ProposalStatusSuffix)
-//}
-//
-//func StateKeyProposalStatus(ca base.Address, daoID ctypes.ContractID, pid string) string {
-//	return fmt.Sprintf("%s-%s%s", StateKeyDAOPrefix(ca, daoID), pid, ProposalStatusSuffix)
-//}
+func NewAddActiveProposalStateValue(pid string) AddActiveProposalStateValue {
+	return AddActiveProposalStateValue{
+		ProposalID: pid,
+	}
+}
+
+func (ap AddActiveProposalStateValue) IsValid([]byte) error {
+	if len(ap.ProposalID) == 0 {
+		return util.ErrInvalid.Errorf("invalid AddActiveProposalStateValue, empty proposal id")
+	}
+
+	return nil
+}
+
+func (ap AddActiveProposalStateValue) HashBytes() []byte {
+	return []byte(ap.ProposalID)
+}
+
+// RemoveActiveProposalStateValue removes the proposal from the active proposals of the dao.
+type RemoveActiveProposalStateValue struct {
+	ProposalID string
+}
+
+func NewRemoveActiveProposalStateValue(pid string) RemoveActiveProposalStateValue {
+	return RemoveActiveProposalStateValue{
+		ProposalID: pid,
+	}
+}
+
+func (rp RemoveActiveProposalStateValue) IsValid([]byte) error {
+	if len(rp.ProposalID) == 0 {
+		return util.ErrInvalid.Errorf("invalid RemoveActiveProposalStateValue, empty proposal id")
+	}
+
+	return nil
+}
+
+func (rp RemoveActiveProposalStateValue) HashBytes() []byte {
+	return []byte(rp.ProposalID)
+}
 
 var (
-	VotingPowerBoxStateValueHint = hint.MustNewHint("mitum-dao-voting-power-box-state-value-v0.0.1")
-	VotingPowerBoxSuffix         = "votingpowerbox"
+	WhitelistMemberStateValueHint = hint.MustNewHint("mitum-dao-whitelist-member-state-value-v0.0.1")
+	WhitelistMemberSuffix         = "whitelist-member"
 )
 
-type VotingPowerBoxStateValue struct {
+// WhitelistMemberStateValue keeps whether the account is a managed whitelist
+// member of the dao. A removed member keeps its state as inactive.
+type WhitelistMemberStateValue struct {
 	hint.BaseHinter
-	votingPowerBox types.VotingPowerBox
+	account base.Address
+	active  bool
 }
 
-func NewVotingPowerBoxStateValue(votingPowerBox types.VotingPowerBox) VotingPowerBoxStateValue {
-	return VotingPowerBoxStateValue{
-		BaseHinter:     hint.NewBaseHinter(VotingPowerBoxStateValueHint),
-		votingPowerBox: votingPowerBox,
+func NewWhitelistMemberStateValue(account base.Address, active bool) WhitelistMemberStateValue {
+	return WhitelistMemberStateValue{
+		BaseHinter: hint.NewBaseHinter(WhitelistMemberStateValueHint),
+		account:    account,
+		active:     active,
 	}
 }
 
-func (vb VotingPowerBoxStateValue) Hint() hint.Hint {
-	return vb.BaseHinter.Hint()
+func (wm WhitelistMemberStateValue) Hint() hint.Hint {
+	return wm.BaseHinter.Hint()
 }
 
-func (vb VotingPowerBoxStateValue) VotingPowerBox() types.VotingPowerBox {
-	return vb.votingPowerBox
+func (wm WhitelistMemberStateValue) Account() base.Address {
+	return wm.account
 }
 
-func (vb VotingPowerBoxStateValue) IsValid([]byte) error {
-	e := util.ErrInvalid.Errorf("invalid VotingPowerBoxStateValue")
+func (wm WhitelistMemberStateValue) Active() bool {
+	return wm.active
+}
 
-	if err := vb.BaseHinter.IsValid(VotingPowerBoxStateValueHint.Type().Bytes()); err != nil {
+func (wm WhitelistMemberStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao WhitelistMemberStateValue")
+
+	if err := util.CheckIsValiders(nil, false, wm.account); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := wm.BaseHinter.IsValid(WhitelistMemberStateValueHint.Type().Bytes()); err != nil {
 		return e.Wrap(err)
 	}
 
 	return nil
 }
 
-func (vb VotingPowerBoxStateValue) HashBytes() []byte {
-	return vb.votingPowerBox.Bytes()
+func (wm WhitelistMemberStateValue) HashBytes() []byte {
+	ab := []byte{0}
+	if wm.active {
+		ab[0] = 1
+	}
+
+	return util.ConcatBytesSlice(wm.account.Bytes(), ab)
 }
 
-func StateVotingPowerBoxValue(st base.State) (types.VotingPowerBox, error) {
+func StateWhitelistMemberValue(st base.State) (WhitelistMemberStateValue, error) {
 	v := st.Value()
 	if v == nil {
-		return types.VotingPowerBox{}, util.ErrNotFound.Errorf("VotingPowerBox not found in State")
+		return WhitelistMemberStateValue{}, util.ErrNotFound.Errorf("whitelist member not found in State")
 	}
 
-	r, ok := v.(VotingPowerBoxStateValue)
+	wm, ok := v.(WhitelistMemberStateValue)
 	if !ok {
-		return types.VotingPowerBox{}, errors.Errorf("invalid VotingPowerBox value found, %T", v)
+		return WhitelistMemberStateValue{}, errors.Errorf("invalid whitelist member value found, %T", v)
 	}
 
-	return r.votingPowerBox, nil
+	return wm, nil
 }
 
-func IsStateVotingPowerBoxKey(key string) bool {
-	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VotingPowerBoxSuffix)
+func IsStateWhitelistMemberKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, WhitelistMemberSuffix)
 }
 
-func StateKeyVotingPowerBox(ca base.Address, pid string) string {
-	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VotingPowerBoxSuffix)
+func StateKeyWhitelistMember(ca base.Address, account base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), account, WhitelistMemberSuffix)
+}
+
+var (
+	VoterAllowlistMemberStateValueHint = hint.MustNewHint("mitum-dao-voter-allowlist-member-state-value-v0.0.1")
+	VoterAllowlistMemberSuffix         = "voter-allowlist-member"
+)
+
+// VoterAllowlistMemberStateValue keeps whether the account is in the voter
+// allowlist of the dao. A removed member keeps its state as inactive.
+type VoterAllowlistMemberStateValue struct {
+	hint.BaseHinter
+	account base.Address
+	active  bool
+}
+
+func NewVoterAllowlistMemberStateValue(account base.Address, active bool) VoterAllowlistMemberStateValue {
+	return VoterAllowlistMemberStateValue{
+		BaseHinter: hint.NewBaseHinter(VoterAllowlistMemberStateValueHint),
+		account:    account,
+		active:     active,
+	}
+}
+
+func (vm VoterAllowlistMemberStateValue) Hint() hint.Hint {
+	return vm.BaseHinter.Hint()
+}
+
+func (vm VoterAllowlistMemberStateValue) Account() base.Address {
+	return vm.account
+}
+
+func (vm VoterAllowlistMemberStateValue) Active() bool {
+	return vm.active
+}
+
+func (vm VoterAllowlistMemberStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao VoterAllowlistMemberStateValue")
+
+	if err := util.CheckIsValiders(nil, false, vm.account); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := vm.BaseHinter.IsValid(VoterAllowlistMemberStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (vm VoterAllowlistMemberStateValue) HashBytes() []byte {
+	ab := []byte{0}
+	if vm.active {
+		ab[0] = 1
+	}
+
+	return util.ConcatBytesSlice(vm.account.Bytes(), ab)
+}
+
+func StateVoterAllowlistMemberValue(st base.State) (VoterAllowlistMemberStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return VoterAllowlistMemberStateValue{}, util.ErrNotFound.Errorf("voter allowlist member not found in State")
+	}
+
+	vm, ok := v.(VoterAllowlistMemberStateValue)
+	if !ok {
+		return VoterAllowlistMemberStateValue{}, errors.Errorf("invalid voter allowlist member value found, %T", v)
+	}
+
+	return vm, nil
+}
+
+func IsStateVoterAllowlistMemberKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VoterAllowlistMemberSuffix)
+}
+
+func StateKeyVoterAllowlistMember(ca base.Address, account base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), account, VoterAllowlistMemberSuffix)
+}
+
+var (
+	RolesStateValueHint = hint.MustNewHint("mitum-dao-roles-state-value-v0.0.1")
+	RolesSuffix         = "roles"
+)
+
+// RolesStateValue keeps the roles the account holds in the dao.
+type RolesStateValue struct {
+	hint.BaseHinter
+	account base.Address
+	roles   []types.Role
+}
+
+func NewRolesStateValue(account base.Address, roles []types.Role) RolesStateValue {
+	return RolesStateValue{
+		BaseHinter: hint.NewBaseHinter(RolesStateValueHint),
+		account:    account,
+		roles:      roles,
+	}
+}
+
+func (rs RolesStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RolesStateValue) Account() base.Address {
+	return rs.account
+}
+
+func (rs RolesStateValue) Roles() []types.Role {
+	return rs.roles
+}
+
+func (rs RolesStateValue) HasRole(role types.Role) bool {
+	for _, r := range rs.roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rs RolesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao RolesStateValue")
+
+	if err := util.CheckIsValiders(nil, false, rs.account); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rs.BaseHinter.IsValid(RolesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[types.Role]struct{}{}
+	for _, r := range rs.roles {
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[r]; found {
+			return e.Wrap(errors.Errorf("duplicated role, %v", r))
+		}
+		founds[r] = struct{}{}
+	}
+
+	return nil
+}
+
+func (rs RolesStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(rs.roles))
+	for i := range rs.roles {
+		bs[i] = rs.roles[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(rs.account.Bytes(), util.ConcatBytesSlice(bs...))
+}
+
+func StateRolesValue(st base.State) (RolesStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RolesStateValue{}, util.ErrNotFound.Errorf("roles not found in State")
+	}
+
+	rs, ok := v.(RolesStateValue)
+	if !ok {
+		return RolesStateValue{}, errors.Errorf("invalid roles value found, %T", v)
+	}
+
+	return rs, nil
+}
+
+func IsStateRolesKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, RolesSuffix)
+}
+
+func StateKeyRoles(ca base.Address, account base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), account, RolesSuffix)
+}
+
+var (
+	RoleHoldersStateValueHint = hint.MustNewHint("mitum-dao-role-holders-state-value-v0.0.1")
+	RoleHoldersSuffix         = "role-holders"
+)
+
+// RoleHoldersStateValue keeps the number of accounts holding the role in the dao.
+type RoleHoldersStateValue struct {
+	hint.BaseHinter
+	role    types.Role
+	holders uint64
+}
+
+func NewRoleHoldersStateValue(role types.Role, holders uint64) RoleHoldersStateValue {
+	return RoleHoldersStateValue{
+		BaseHinter: hint.NewBaseHinter(RoleHoldersStateValueHint),
+		role:       role,
+		holders:    holders,
+	}
+}
+
+func (rh RoleHoldersStateValue) Hint() hint.Hint {
+	return rh.BaseHinter.Hint()
+}
+
+func (rh RoleHoldersStateValue) Role() types.Role {
+	return rh.role
+}
+
+func (rh RoleHoldersStateValue) Holders() uint64 {
+	return rh.holders
+}
+
+func (rh RoleHoldersStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao RoleHoldersStateValue")
+
+	if err := util.CheckIsValiders(nil, false, rh.role); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rh.BaseHinter.IsValid(RoleHoldersStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rh RoleHoldersStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(rh.role.Bytes(), util.Uint64ToBytes(rh.holders))
+}
+
+func StateRoleHoldersValue(st base.State) (RoleHoldersStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RoleHoldersStateValue{}, util.ErrNotFound.Errorf("role holders not found in State")
+	}
+
+	rh, ok := v.(RoleHoldersStateValue)
+	if !ok {
+		return RoleHoldersStateValue{}, errors.Errorf("invalid role holders value found, %T", v)
+	}
+
+	return rh, nil
+}
+
+func IsStateRoleHoldersKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, RoleHoldersSuffix)
+}
+
+func StateKeyRoleHolders(ca base.Address, role types.Role) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), role, RoleHoldersSuffix)
+}
+
+var (
+	DelegatorsStateValueHint = hint.MustNewHint("mitum-dao-delegators-state-value-v0.0.1")
+	DelegatorsSuffix         = "delegators"
+)
+
+type DelegatorsStateValue struct {
+	hint.BaseHinter
+	delegators []types.DelegatorInfo
+}
+
+func NewDelegatorsStateValue(delegators []types.DelegatorInfo) DelegatorsStateValue {
+	return DelegatorsStateValue{
+		BaseHinter: hint.NewBaseHinter(DelegatorsStateValueHint),
+		delegators: delegators,
+	}
+}
+
+func (dg DelegatorsStateValue) Hint() hint.Hint {
+	return dg.BaseHinter.Hint()
+}
+
+func (dg DelegatorsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao DelegatorsStateValue")
+
+	if err := dg.BaseHinter.IsValid(DelegatorsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, ac := range dg.delegators {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (dg DelegatorsStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(dg.delegators))
+
+	for i, delegator := range dg.delegators {
+		ba[i] = delegator.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+func StateDelegatorsValue(st base.State) ([]types.DelegatorInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("delegators not found in State")
+	}
+
+	ap, ok := v.(DelegatorsStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid delegators value found, %T", v)
+	}
+
+	return ap.delegators, nil
+}
+
+func IsStateDelegatorsKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DelegatorsSuffix)
+}
+
+func StateKeyDelegators(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, DelegatorsSuffix)
+}
+
+var (
+	VotersStateValueHint = hint.MustNewHint("mitum-dao-voters-state-value-v0.0.1")
+	VotersSuffix         = "voters"
+)
+
+type VotersStateValue struct {
+	hint.BaseHinter
+	voters []types.VoterInfo
+}
+
+func NewVotersStateValue(voters []types.VoterInfo) VotersStateValue {
+	return VotersStateValue{
+		BaseHinter: hint.NewBaseHinter(VotersStateValueHint),
+		voters:     voters,
+	}
+}
+
+func (vt VotersStateValue) Hint() hint.Hint {
+	return vt.BaseHinter.Hint()
+}
+
+func (vt VotersStateValue) Voters() []types.VoterInfo {
+	return vt.voters
+}
+
+func (vt VotersStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao VotersStateValue")
+
+	if err := vt.BaseHinter.IsValid(VotersStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, info := range vt.voters {
+		if err := info.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[info.Account().String()]; found {
+			return e.Wrap(errors.Errorf("duplicate voter account found, %q", info.Account()))
+		}
+	}
+
+	return nil
+}
+
+func (vt VotersStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(vt.voters))
+
+	for i, br := range vt.voters {
+		bs[i] = br.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateVotersValue(st base.State) ([]types.VoterInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("voters not found in State")
+	}
+
+	r, ok := v.(VotersStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid voters value found, %T", v)
+	}
+
+	return r.voters, nil
+}
+
+func IsStateVotersKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VotersSuffix)
+}
+
+func StateKeyVoters(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VotersSuffix)
+}
+
+//var (
+//	ProposalStatusStateValueHint = hint.MustNewHint("mitum-dao-proposal-status-state-value-v0.0.1")
+//	ProposalStatusSuffix         = ":proposalstatus"
+//)
+
+//type ProposalStatusStateValue struct {
+//	hint.BaseHinter
+//	proposalStatus types.ProposalStatus
+//}
+//
+//func NewProposalStatusStateValue(proposalStatus types.ProposalStatus) ProposalStatusStateValue {
+//	return ProposalStatusStateValue{
+//		BaseHinter:     hint.NewBaseHinter(ProposalStatusStateValueHint),
+//		proposalStatus: proposalStatus,
+//	}
+//}
+//
+//func (ps ProposalStatusStateValue) Hint() hint.Hint {
+//	return ps.BaseHinter.Hint()
+//}
+//
+//func (ps ProposalStatusStateValue) ProposalStatus() types.ProposalStatus {
+//	return ps.proposalStatus
+//}
+//
+//func (ps ProposalStatusStateValue) IsValid([]byte) error {
+//	e := util.ErrInvalid.Errorf("invalid ProposalStatusStateValue")
+//
+//	if err := ps.BaseHinter.IsValid(ProposalStatusStateValueHint.Type().Bytes()); err != nil {
+//		return e.Wrap(err)
+//	}
+//
+//	return nil
+//}
+//
+//func (ps ProposalStatusStateValue) HashBytes() []byte {
+//	return ps.proposalStatus.Bytes()
+//}
+//
+//func StateProposalStatusValue(st base.State) (types.ProposalStatus, error) {
+//	v := st.Value()
+//	if v == nil {
+//		return types.NilStatus, util.ErrNotFound.Errorf("ProposalStatus value not found in State")
+//	}
+//
+//	ps, ok := v.(ProposalStatusStateValue)
+//	if !ok {
+//		return types.NilStatus, errors.Errorf("invalid ProposalStatus value found, %T", v)
+//	}
+//
+//	return ps.proposalStatus, nil
+//}
+//
+//func IsStateProposalStatusKey(key string) bool {
+//	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, ProposalStatusSuffix)
+//}
+//
+//func StateKeyProposalStatus(ca base.Address, daoID ctypes.ContractID, pid string) string {
+//	return fmt.Sprintf("%s-%s%s", StateKeyDAOPrefix(ca, daoID), pid, ProposalStatusSuffix)
+//}
+
+var (
+	VotingPowerBoxStateValueHint = hint.MustNewHint("mitum-dao-voting-power-box-state-value-v0.0.1")
+	VotingPowerBoxSuffix         = "votingpowerbox"
+)
+
+type VotingPowerBoxStateValue struct {
+	hint.BaseHinter
+	votingPowerBox types.VotingPowerBox
+}
+
+func NewVotingPowerBoxStateValue(votingPowerBox types.VotingPowerBox) VotingPowerBoxStateValue {
+	return VotingPowerBoxStateValue{
+		BaseHinter:     hint.NewBaseHinter(VotingPowerBoxStateValueHint),
+		votingPowerBox: votingPowerBox,
+	}
+}
+
+func (vb VotingPowerBoxStateValue) Hint() hint.Hint {
+	return vb.BaseHinter.Hint()
+}
+
+func (vb VotingPowerBoxStateValue) VotingPowerBox() types.VotingPowerBox {
+	return vb.votingPowerBox
+}
+
+func (vb VotingPowerBoxStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid VotingPowerBoxStateValue")
+
+	if err := vb.BaseHinter.IsValid(VotingPowerBoxStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (vb VotingPowerBoxStateValue) HashBytes() []byte {
+	return vb.votingPowerBox.Bytes()
+}
+
+func StateVotingPowerBoxValue(st base.State) (types.VotingPowerBox, error) {
+	v := st.Value()
+	if v == nil {
+		return types.VotingPowerBox{}, util.ErrNotFound.Errorf("VotingPowerBox not found in State")
+	}
+
+	r, ok := v.(VotingPowerBoxStateValue)
+	if !ok {
+		return types.VotingPowerBox{}, errors.Errorf("invalid VotingPowerBox value found, %T", v)
+	}
+
+	return r.votingPowerBox, nil
+}
+
+func IsStateVotingPowerBoxKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VotingPowerBoxSuffix)
+}
+
+func StateKeyVotingPowerBox(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VotingPowerBoxSuffix)
+}
+
+var (
+	DepositContributionsStateValueHint = hint.MustNewHint("mitum-dao-deposit-contributions-state-value-v0.0.1")
+	DepositContributionsSuffix         = "deposit-contributions"
+)
+
+type DepositContributionsStateValue struct {
+	hint.BaseHinter
+	contributions []types.DepositContribution
+}
+
+func NewDepositContributionsStateValue(contributions []types.DepositContribution) DepositContributionsStateValue {
+	return DepositContributionsStateValue{
+		BaseHinter:    hint.NewBaseHinter(DepositContributionsStateValueHint),
+		contributions: contributions,
+	}
+}
+
+func (dc DepositContributionsStateValue) Hint() hint.Hint {
+	return dc.BaseHinter.Hint()
+}
+
+func (dc DepositContributionsStateValue) Contributions() []types.DepositContribution {
+	return dc.contributions
+}
+
+func (dc DepositContributionsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid DepositContributionsStateValue")
+
+	if err := dc.BaseHinter.IsValid(DepositContributionsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, c := range dc.contributions {
+		if err := c.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[c.Contributor().String()]; found {
+			return e.Wrap(errors.Errorf("duplicate contributor found, %q", c.Contributor()))
+		}
+		founds[c.Contributor().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (dc DepositContributionsStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(dc.contributions))
+
+	for i, c := range dc.contributions {
+		bs[i] = c.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateDepositContributionsValue(st base.State) ([]types.DepositContribution, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("deposit contributions not found in State")
+	}
+
+	dc, ok := v.(DepositContributionsStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid deposit contributions value found, %T", v)
+	}
+
+	return dc.contributions, nil
+}
+
+func IsStateDepositContributionsKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DepositContributionsSuffix)
+}
+
+func StateKeyDepositContributions(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, DepositContributionsSuffix)
+}
+
+// AddDepositContributionStateValue adds the contribution to the deposit contributions of the
+// proposal through DepositContributionsStateValueMerger; the contributions of the same contributor
+// are summed.
+type AddDepositContributionStateValue struct {
+	Contribution types.DepositContribution
+}
+
+func NewAddDepositContributionStateValue(contribution types.DepositContribution) AddDepositContributionStateValue {
+	return AddDepositContributionStateValue{
+		Contribution: contribution,
+	}
+}
+
+func (ac AddDepositContributionStateValue) IsValid([]byte) error {
+	if err := ac.Contribution.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid AddDepositContributionStateValue, %v", err)
+	}
+
+	return nil
+}
+
+func (ac AddDepositContributionStateValue) HashBytes() []byte {
+	return ac.Contribution.Bytes()
+}
+
+// AddDepositStateValue adds the deposit of the operation to the pending deposit proposal through
+// ProposalDepositStateValueMerger, and to its status history through
+// DepositStatusHistoryStateValueMerger when the deposit reaches the deposit target.
+type AddDepositStateValue struct {
+	Amount   ctypes.Amount
+	FactHash util.Hash
+}
+
+func NewAddDepositStateValue(amount ctypes.Amount, factHash util.Hash) AddDepositStateValue {
+	return AddDepositStateValue{
+		Amount:   amount,
+		FactHash: factHash,
+	}
+}
+
+func (ad AddDepositStateValue) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, ad.Amount, ad.FactHash); err != nil {
+		return util.ErrInvalid.Errorf("invalid AddDepositStateValue, %v", err)
+	}
+
+	return nil
+}
+
+func (ad AddDepositStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(ad.Amount.Bytes(), ad.FactHash.Bytes())
+}
+
+var (
+	LockedDepositStateValueHint = hint.MustNewHint("mitum-dao-locked-deposit-state-value-v0.0.1")
+	LockedDepositSuffix         = "locked-deposit"
+)
+
+// LockedDepositStateValue keeps the total of the proposal deposits of a currency which are locked in
+// the contract account. The locked deposits are in the contract balance, but they are not a part of
+// the treasury which the proposals can spend. It is updated by LockDepositStateValue and
+// UnlockDepositStateValue through LockedDepositStateValueMerger.
+type LockedDepositStateValue struct {
+	hint.BaseHinter
+	amount ctypes.Amount
+}
+
+func NewLockedDepositStateValue(amount ctypes.Amount) LockedDepositStateValue {
+	return LockedDepositStateValue{
+		BaseHinter: hint.NewBaseHinter(LockedDepositStateValueHint),
+		amount:     amount,
+	}
+}
+
+func (ld LockedDepositStateValue) Hint() hint.Hint {
+	return ld.BaseHinter.Hint()
+}
+
+func (ld LockedDepositStateValue) Amount() ctypes.Amount {
+	return ld.amount
+}
+
+func (ld LockedDepositStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid LockedDepositStateValue")
+
+	if err := util.CheckIsValiders(nil, false,
+		ld.BaseHinter,
+		ld.amount,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ld LockedDepositStateValue) HashBytes() []byte {
+	return ld.amount.Bytes()
+}
+
+func StateLockedDepositValue(st base.State) (ctypes.Amount, error) {
+	v := st.Value()
+	if v == nil {
+		return ctypes.Amount{}, util.ErrNotFound.Errorf("locked deposit not found in State")
+	}
+
+	ld, ok := v.(LockedDepositStateValue)
+	if !ok {
+		return ctypes.Amount{}, errors.Errorf("invalid locked deposit value found, %T", v)
+	}
+
+	return ld.amount, nil
+}
+
+func IsStateLockedDepositKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, LockedDepositSuffix)
+}
+
+func StateKeyLockedDeposit(ca base.Address, cid ctypes.CurrencyID) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), cid, LockedDepositSuffix)
+}
+
+// LockDepositStateValue adds the deposit to the locked deposits of the currency.
+type LockDepositStateValue struct {
+	Amount ctypes.Amount
+}
+
+func NewLockDepositStateValue(amount ctypes.Amount) LockDepositStateValue {
+	return LockDepositStateValue{
+		Amount: amount,
+	}
+}
+
+func (ld LockDepositStateValue) IsValid([]byte) error {
+	if err := ld.Amount.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid LockDepositStateValue, %v", err)
+	}
+
+	return nil
+}
+
+func (ld LockDepositStateValue) HashBytes() []byte {
+	return ld.Amount.Bytes()
+}
+
+// UnlockDepositStateValue removes the settled deposit from the locked deposits of the currency.
+type UnlockDepositStateValue struct {
+	Amount ctypes.Amount
+}
+
+func NewUnlockDepositStateValue(amount ctypes.Amount) UnlockDepositStateValue {
+	return UnlockDepositStateValue{
+		Amount: amount,
+	}
+}
+
+func (ud UnlockDepositStateValue) IsValid([]byte) error {
+	if err := ud.Amount.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid UnlockDepositStateValue, %v", err)
+	}
+
+	return nil
+}
+
+func (ud UnlockDepositStateValue) HashBytes() []byte {
+	return ud.Amount.Bytes()
+}
+
+var (
+	VetoesStateValueHint = hint.MustNewHint("mitum-dao-vetoes-state-value-v0.0.1")
+	VetoesSuffix         = "vetoes"
+)
+
+type VetoesStateValue struct {
+	hint.BaseHinter
+	vetoes []types.VetoInfo
+}
+
+func NewVetoesStateValue(vetoes []types.VetoInfo) VetoesStateValue {
+	return VetoesStateValue{
+		BaseHinter: hint.NewBaseHinter(VetoesStateValueHint),
+		vetoes:     vetoes,
+	}
+}
+
+func (vs VetoesStateValue) Hint() hint.Hint {
+	return vs.BaseHinter.Hint()
+}
+
+func (vs VetoesStateValue) Vetoes() []types.VetoInfo {
+	return vs.vetoes
+}
+
+func (vs VetoesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid VetoesStateValue")
+
+	if err := vs.BaseHinter.IsValid(VetoesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, v := range vs.vetoes {
+		if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[v.Guardian().String()]; found {
+			return e.Wrap(errors.Errorf("duplicate guardian found, %q", v.Guardian()))
+		}
+		founds[v.Guardian().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (vs VetoesStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(vs.vetoes))
+
+	for i, v := range vs.vetoes {
+		bs[i] = v.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateVetoesValue(st base.State) ([]types.VetoInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("vetoes not found in State")
+	}
+
+	vs, ok := v.(VetoesStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid vetoes value found, %T", v)
+	}
+
+	return vs.vetoes, nil
+}
+
+func IsStateVetoesKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VetoesSuffix)
+}
+
+func StateKeyVetoes(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VetoesSuffix)
+}
+
+var (
+	SponsorsStateValueHint = hint.MustNewHint("mitum-dao-sponsors-state-value-v0.0.1")
+	SponsorsSuffix         = "sponsors"
+)
+
+type SponsorsStateValue struct {
+	hint.BaseHinter
+	sponsors []types.SponsorInfo
+}
+
+func NewSponsorsStateValue(sponsors []types.SponsorInfo) SponsorsStateValue {
+	return SponsorsStateValue{
+		BaseHinter: hint.NewBaseHinter(SponsorsStateValueHint),
+		sponsors:   sponsors,
+	}
+}
+
+func (ss SponsorsStateValue) Hint() hint.Hint {
+	return ss.BaseHinter.Hint()
+}
+
+func (ss SponsorsStateValue) Sponsors() []types.SponsorInfo {
+	return ss.sponsors
+}
+
+func (ss SponsorsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SponsorsStateValue")
+
+	if err := ss.BaseHinter.IsValid(SponsorsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, v := range ss.sponsors {
+		if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[v.Sponsor().String()]; found {
+			return e.Wrap(errors.Errorf("duplicate sponsor found, %q", v.Sponsor()))
+		}
+		founds[v.Sponsor().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ss SponsorsStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(ss.sponsors))
+
+	for i, v := range ss.sponsors {
+		bs[i] = v.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateSponsorsValue(st base.State) ([]types.SponsorInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("sponsors not found in State")
+	}
+
+	ss, ok := v.(SponsorsStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid sponsors value found, %T", v)
+	}
+
+	return ss.sponsors, nil
+}
+
+func IsStateSponsorsKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, SponsorsSuffix)
+}
+
+func StateKeySponsors(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, SponsorsSuffix)
+}
+
+var (
+	ExecutionHistoryStateValueHint = hint.MustNewHint("mitum-dao-execution-history-state-value-v0.0.1")
+	ExecutionHistorySuffix         = "execution-history"
+)
+
+type ExecutionHistoryStateValue struct {
+	hint.BaseHinter
+	results []types.ExecutionResult
+}
+
+func NewExecutionHistoryStateValue(results []types.ExecutionResult) ExecutionHistoryStateValue {
+	return ExecutionHistoryStateValue{
+		BaseHinter: hint.NewBaseHinter(ExecutionHistoryStateValueHint),
+		results:    results,
+	}
+}
+
+func (eh ExecutionHistoryStateValue) Hint() hint.Hint {
+	return eh.BaseHinter.Hint()
+}
+
+func (eh ExecutionHistoryStateValue) Results() []types.ExecutionResult {
+	return eh.results
+}
+
+func (eh ExecutionHistoryStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ExecutionHistoryStateValue")
+
+	if err := eh.BaseHinter.IsValid(ExecutionHistoryStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range eh.results {
+		if err := eh.results[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (eh ExecutionHistoryStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(eh.results))
+	for i := range eh.results {
+		bs[i] = eh.results[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateExecutionHistoryValue(st base.State) ([]types.ExecutionResult, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("execution history not found in State")
+	}
+
+	r, ok := v.(ExecutionHistoryStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid execution history value found, %T", v)
+	}
+
+	return r.results, nil
+}
+
+func IsStateExecutionHistoryKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, ExecutionHistorySuffix)
+}
+
+func StateKeyExecutionHistory(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, ExecutionHistorySuffix)
+}
+
+var (
+	StatusHistoryStateValueHint = hint.MustNewHint("mitum-dao-status-history-state-value-v0.0.1")
+	StatusHistorySuffix         = "status-history"
+)
+
+type StatusHistoryStateValue struct {
+	hint.BaseHinter
+	transitions []types.StatusTransition
+}
+
+func NewStatusHistoryStateValue(transitions []types.StatusTransition) StatusHistoryStateValue {
+	return StatusHistoryStateValue{
+		BaseHinter:  hint.NewBaseHinter(StatusHistoryStateValueHint),
+		transitions: transitions,
+	}
+}
+
+func (sh StatusHistoryStateValue) Hint() hint.Hint {
+	return sh.BaseHinter.Hint()
+}
+
+func (sh StatusHistoryStateValue) Transitions() []types.StatusTransition {
+	return sh.transitions
+}
+
+func (sh StatusHistoryStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StatusHistoryStateValue")
+
+	if err := sh.BaseHinter.IsValid(StatusHistoryStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range sh.transitions {
+		if err := sh.transitions[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (sh StatusHistoryStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(sh.transitions))
+	for i := range sh.transitions {
+		bs[i] = sh.transitions[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateStatusHistoryValue(st base.State) ([]types.StatusTransition, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("status history not found in State")
+	}
+
+	r, ok := v.(StatusHistoryStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid status history value found, %T", v)
+	}
+
+	return r.transitions, nil
+}
+
+func IsStateStatusHistoryKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, StatusHistorySuffix)
+}
+
+func StateKeyStatusHistory(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, StatusHistorySuffix)
+}
+
+var (
+	TallyStateValueHint = hint.MustNewHint("mitum-dao-tally-state-value-v0.0.1")
+	TallySuffix         = "tally"
+)
+
+type TallyStateValue struct {
+	hint.BaseHinter
+	tally types.Tally
+}
+
+func NewTallyStateValue(tally types.Tally) TallyStateValue {
+	return TallyStateValue{
+		BaseHinter: hint.NewBaseHinter(TallyStateValueHint),
+		tally:      tally,
+	}
+}
+
+func (ts TallyStateValue) Hint() hint.Hint {
+	return ts.BaseHinter.Hint()
+}
+
+func (ts TallyStateValue) Tally() types.Tally {
+	return ts.tally
+}
+
+func (ts TallyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid TallyStateValue")
+
+	if err := ts.BaseHinter.IsValid(TallyStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ts.tally.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ts TallyStateValue) HashBytes() []byte {
+	return ts.tally.Bytes()
+}
+
+func StateTallyValue(st base.State) (types.Tally, error) {
+	v := st.Value()
+	if v == nil {
+		return types.Tally{}, util.ErrNotFound.Errorf("tally not found in State")
+	}
+
+	r, ok := v.(TallyStateValue)
+	if !ok {
+		return types.Tally{}, errors.Errorf("invalid tally value found, %T", v)
+	}
+
+	return r.tally, nil
+}
+
+func IsStateTallyKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, TallySuffix)
+}
+
+func StateKeyTally(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, TallySuffix)
 }
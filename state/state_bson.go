@@ -1,10 +1,13 @@
 package state
 
 import (
+	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/currency-model/utils/bsonenc"
 	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
@@ -12,15 +15,23 @@ import (
 func (de DesignStateValue) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":  de.Hint().String(),
-			"design": de.design,
+			"_hint":           de.Hint().String(),
+			"design":          de.design,
+			"admin_renounced": de.adminRenounced,
+			"policy_version":  de.policyVersion,
+			"height":          de.height,
+			"fact_hash":       de.factHash.String(),
 		},
 	)
 }
 
 type DesignStateValueBSONUnmarshaler struct {
-	Hint   string   `bson:"_hint"`
-	Design bson.Raw `bson:"design"`
+	Hint           string   `bson:"_hint"`
+	Design         bson.Raw `bson:"design"`
+	AdminRenounced bool     `bson:"admin_renounced"`
+	PolicyVersion  uint64   `bson:"policy_version"`
+	Height         int64    `bson:"height"`
+	FactHash       string   `bson:"fact_hash"`
 }
 
 func (de *DesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -48,6 +59,243 @@ func (de *DesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 
 	de.design = design
+	de.adminRenounced = u.AdminRenounced
+	de.policyVersion = u.PolicyVersion
+	de.height = base.Height(u.Height)
+	de.factHash = valuehash.NewBytesFromString(u.FactHash)
+
+	return nil
+}
+
+func (pc ProposalCounterStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    pc.Hint().String(),
+			"sequence": pc.sequence,
+		},
+	)
+}
+
+type ProposalCounterStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Sequence uint64 `bson:"sequence"`
+}
+
+func (pc *ProposalCounterStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalCounterStateValue")
+
+	var u ProposalCounterStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	pc.BaseHinter = hint.NewBaseHinter(ht)
+	pc.sequence = u.Sequence
+
+	return nil
+}
+
+func (ap ActiveProposalsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        ap.Hint().String(),
+			"proposal_ids": ap.proposalIDs,
+		},
+	)
+}
+
+type ActiveProposalsStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	ProposalIDs []string `bson:"proposal_ids"`
+}
+
+func (ap *ActiveProposalsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ActiveProposalsStateValue")
+
+	var u ActiveProposalsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ap.BaseHinter = hint.NewBaseHinter(ht)
+	ap.proposalIDs = u.ProposalIDs
+
+	return nil
+}
+
+func (wm WhitelistMemberStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   wm.Hint().String(),
+			"account": wm.account,
+			"active":  wm.active,
+		},
+	)
+}
+
+type WhitelistMemberStateValueBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Account string `bson:"account"`
+	Active  bool   `bson:"active"`
+}
+
+func (wm *WhitelistMemberStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of WhitelistMemberStateValue")
+
+	var u WhitelistMemberStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	wm.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(u.Account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		wm.account = a
+	}
+	wm.active = u.Active
+
+	return nil
+}
+
+func (vm VoterAllowlistMemberStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   vm.Hint().String(),
+			"account": vm.account,
+			"active":  vm.active,
+		},
+	)
+}
+
+type VoterAllowlistMemberStateValueBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Account string `bson:"account"`
+	Active  bool   `bson:"active"`
+}
+
+func (vm *VoterAllowlistMemberStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of VoterAllowlistMemberStateValue")
+
+	var u VoterAllowlistMemberStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	vm.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(u.Account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		vm.account = a
+	}
+	vm.active = u.Active
+
+	return nil
+}
+
+func (rs RolesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   rs.Hint().String(),
+			"account": rs.account,
+			"roles":   rs.roles,
+		},
+	)
+}
+
+type RolesStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Account string   `bson:"account"`
+	Roles   []string `bson:"roles"`
+}
+
+func (rs *RolesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RolesStateValue")
+
+	var u RolesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	rs.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(u.Account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		rs.account = a
+	}
+
+	roles := make([]types.Role, len(u.Roles))
+	for i := range u.Roles {
+		roles[i] = types.Role(u.Roles[i])
+	}
+	rs.roles = roles
+
+	return nil
+}
+
+func (rh RoleHoldersStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   rh.Hint().String(),
+			"role":    rh.role,
+			"holders": rh.holders,
+		},
+	)
+}
+
+type RoleHoldersStateValueBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Role    string `bson:"role"`
+	Holders uint64 `bson:"holders"`
+}
+
+func (rh *RoleHoldersStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RoleHoldersStateValue")
+
+	var u RoleHoldersStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	rh.BaseHinter = hint.NewBaseHinter(ht)
+	rh.role = types.Role(u.Role)
+	rh.holders = u.Holders
 
 	return nil
 }
@@ -55,21 +303,31 @@ func (de *DesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 func (p ProposalStateValue) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    p.Hint().String(),
-			"status":   p.status,
-			"reason":   p.reason,
-			"proposal": p.proposal,
-			"policy":   p.policy,
+			"_hint":          p.Hint().String(),
+			"status":         p.status,
+			"reason":         p.reason,
+			"reason_detail":  p.reasonDetail,
+			"proposal":       p.proposal,
+			"sequence":       p.sequence,
+			"amendments":     p.amendments,
+			"policy":         p.policy,
+			"policy_version": p.policyVersion,
+			"deposit":        p.deposit,
 		},
 	)
 }
 
 type ProposalStateValueBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	Status   uint8    `bson:"status"`
-	Reason   string   `bson:"reason"`
-	Proposal bson.Raw `bson:"proposal"`
-	Policy   bson.Raw `bson:"policy"`
+	Hint          string   `bson:"_hint"`
+	Status        uint8    `bson:"status"`
+	Reason        string   `bson:"reason"`
+	ReasonDetail  bson.Raw `bson:"reason_detail"`
+	Proposal      bson.Raw `bson:"proposal"`
+	Sequence      uint64   `bson:"sequence"`
+	Amendments    bson.Raw `bson:"amendments"`
+	Policy        bson.Raw `bson:"policy"`
+	PolicyVersion uint64   `bson:"policy_version"`
+	Deposit       bson.Raw `bson:"deposit"`
 }
 
 func (p *ProposalStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -107,8 +365,46 @@ func (p *ProposalStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		p.policy = po
 	}
 
+	switch hinter, err := enc.Decode(u.Deposit); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		p.deposit = legacyDeposit(p.proposal, p.policy)
+	default:
+		if dp, ok := hinter.(types.Deposit); !ok {
+			return e.Wrap(errors.Errorf("expected Deposit, not %T", hinter))
+		} else {
+			p.deposit = dp
+		}
+	}
+
 	p.status = types.ProposalStatus(types.Option(u.Status))
 	p.reason = u.Reason
+	p.sequence = u.Sequence
+	p.policyVersion = u.PolicyVersion
+
+	ha, err := enc.DecodeSlice(u.Amendments)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	amendments := make([]types.Amendment, len(ha))
+	for i, hinter := range ha {
+		if a, ok := hinter.(types.Amendment); !ok {
+			return e.Wrap(errors.Errorf("expected types.Amendment, not %T", hinter))
+		} else {
+			amendments[i] = a
+		}
+	}
+	p.amendments = amendments
+
+	rd := legacyReasonDetail()
+	if 0 < len(u.ReasonDetail) {
+		if err := rd.DecodeBSON(u.ReasonDetail, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+	p.reasonDetail = rd
 
 	return nil
 }
@@ -251,3 +547,326 @@ func (vb *VotingPowerBoxStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) e
 
 	return nil
 }
+
+func (dc DepositContributionsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         dc.Hint().String(),
+			"contributions": dc.contributions,
+		},
+	)
+}
+
+type DepositContributionsStateValueBSONUnmarshaler struct {
+	Hint          string   `bson:"_hint"`
+	Contributions bson.Raw `bson:"contributions"`
+}
+
+func (dc *DepositContributionsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DepositContributionsStateValue")
+
+	var u DepositContributionsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	dc.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Contributions)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	contributions := make([]types.DepositContribution, len(hr))
+	for i, hinter := range hr {
+		if c, ok := hinter.(types.DepositContribution); !ok {
+			return e.Wrap(errors.Errorf("expected types.DepositContribution, not %T", hinter))
+		} else if err := c.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			contributions[i] = c
+		}
+	}
+	dc.contributions = contributions
+
+	return nil
+}
+
+func (vs VetoesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  vs.Hint().String(),
+			"vetoes": vs.vetoes,
+		},
+	)
+}
+
+type VetoesStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Vetoes bson.Raw `bson:"vetoes"`
+}
+
+func (vs *VetoesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of VetoesStateValue")
+
+	var u VetoesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	vs.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Vetoes)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	vetoes := make([]types.VetoInfo, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.VetoInfo); !ok {
+			return e.Wrap(errors.Errorf("expected types.VetoInfo, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			vetoes[i] = v
+		}
+	}
+	vs.vetoes = vetoes
+
+	return nil
+}
+
+func (ss SponsorsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    ss.Hint().String(),
+			"sponsors": ss.sponsors,
+		},
+	)
+}
+
+type SponsorsStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Sponsors bson.Raw `bson:"sponsors"`
+}
+
+func (ss *SponsorsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SponsorsStateValue")
+
+	var u SponsorsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ss.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Sponsors)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	sponsors := make([]types.SponsorInfo, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.SponsorInfo); !ok {
+			return e.Wrap(errors.Errorf("expected types.SponsorInfo, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			sponsors[i] = v
+		}
+	}
+	ss.sponsors = sponsors
+
+	return nil
+}
+
+func (eh ExecutionHistoryStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":             eh.Hint().String(),
+			"execution_results": eh.results,
+		},
+	)
+}
+
+type ExecutionHistoryStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Results bson.Raw `bson:"execution_results"`
+}
+
+func (eh *ExecutionHistoryStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ExecutionHistoryStateValue")
+
+	var u ExecutionHistoryStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	eh.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Results)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	results := make([]types.ExecutionResult, len(hr))
+	for i, hinter := range hr {
+		if r, ok := hinter.(types.ExecutionResult); !ok {
+			return e.Wrap(errors.Errorf("expected types.ExecutionResult, not %T", hinter))
+		} else if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			results[i] = r
+		}
+	}
+	eh.results = results
+
+	return nil
+}
+
+func (sh StatusHistoryStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":              sh.Hint().String(),
+			"status_transitions": sh.transitions,
+		},
+	)
+}
+
+type StatusHistoryStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Transitions bson.Raw `bson:"status_transitions"`
+}
+
+func (sh *StatusHistoryStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of StatusHistoryStateValue")
+
+	var u StatusHistoryStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	sh.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Transitions)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	transitions := make([]types.StatusTransition, len(hr))
+	for i, hinter := range hr {
+		if r, ok := hinter.(types.StatusTransition); !ok {
+			return e.Wrap(errors.Errorf("expected types.StatusTransition, not %T", hinter))
+		} else if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			transitions[i] = r
+		}
+	}
+	sh.transitions = transitions
+
+	return nil
+}
+
+func (ts TallyStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": ts.Hint().String(),
+			"tally": ts.tally,
+		},
+	)
+}
+
+type TallyStateValueBSONUnmarshaler struct {
+	Hint  string   `bson:"_hint"`
+	Tally bson.Raw `bson:"tally"`
+}
+
+func (ts *TallyStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TallyStateValue")
+
+	var u TallyStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ts.BaseHinter = hint.NewBaseHinter(ht)
+
+	var tally types.Tally
+	if err := tally.DecodeBSON(u.Tally, enc); err != nil {
+		return e.Wrap(err)
+	}
+	ts.tally = tally
+
+	return nil
+}
+
+func (ld LockedDepositStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  ld.Hint().String(),
+			"amount": ld.amount,
+		},
+	)
+}
+
+type LockedDepositStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Amount bson.Raw `bson:"amount"`
+}
+
+func (ld *LockedDepositStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of LockedDepositStateValue")
+
+	var u LockedDepositStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ld.BaseHinter = hint.NewBaseHinter(ht)
+
+	if hinter, err := enc.Decode(u.Amount); err != nil {
+		return e.Wrap(err)
+	} else if am, ok := hinter.(ctypes.Amount); !ok {
+		return e.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		ld.amount = am
+	}
+
+	return nil
+}
@@ -3,27 +3,42 @@ package state
 import (
 	"encoding/json"
 
+	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
 	"github.com/pkg/errors"
 )
 
 type DesignStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Design types.Design `json:"design"`
+	Design         types.Design `json:"design"`
+	AdminRenounced bool         `json:"admin_renounced"`
+	PolicyVersion  uint64       `json:"policy_version"`
+	Height         base.Height  `json:"height"`
+	FactHash       util.Hash    `json:"fact_hash"`
 }
 
 func (de DesignStateValue) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(DesignStateValueJSONMarshaler{
-		BaseHinter: de.BaseHinter,
-		Design:     de.design,
+		BaseHinter:     de.BaseHinter,
+		Design:         de.design,
+		AdminRenounced: de.adminRenounced,
+		PolicyVersion:  de.policyVersion,
+		Height:         de.height,
+		FactHash:       de.factHash,
 	})
 }
 
 type DesignStateValueJSONUnmarshaler struct {
-	Design json.RawMessage `json:"design"`
+	Design         json.RawMessage       `json:"design"`
+	AdminRenounced bool                  `json:"admin_renounced"`
+	PolicyVersion  uint64                `json:"policy_version"`
+	Height         base.Height           `json:"height"`
+	FactHash       valuehash.HashDecoder `json:"fact_hash"`
 }
 
 func (de *DesignStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -43,33 +58,260 @@ func (de *DesignStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	} else {
 		de.design = design
 	}
+	de.adminRenounced = u.AdminRenounced
+	de.policyVersion = u.PolicyVersion
+	de.height = u.Height
+	de.factHash = u.FactHash.Hash()
+	if de.factHash == nil {
+		de.factHash = valuehash.NewBytes(nil)
+	}
+
+	return nil
+}
+
+type ProposalCounterStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sequence uint64 `json:"sequence"`
+}
+
+func (pc ProposalCounterStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposalCounterStateValueJSONMarshaler{
+		BaseHinter: pc.BaseHinter,
+		Sequence:   pc.sequence,
+	})
+}
+
+type ProposalCounterStateValueJSONUnmarshaler struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+func (pc *ProposalCounterStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalCounterStateValue")
+
+	var u ProposalCounterStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	pc.sequence = u.Sequence
+
+	return nil
+}
+
+type ActiveProposalsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	ProposalIDs []string `json:"proposal_ids"`
+}
+
+func (ap ActiveProposalsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ActiveProposalsStateValueJSONMarshaler{
+		BaseHinter:  ap.BaseHinter,
+		ProposalIDs: ap.proposalIDs,
+	})
+}
+
+type ActiveProposalsStateValueJSONUnmarshaler struct {
+	ProposalIDs []string `json:"proposal_ids"`
+}
+
+func (ap *ActiveProposalsStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ActiveProposalsStateValue")
+
+	var u ActiveProposalsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ap.proposalIDs = u.ProposalIDs
+
+	return nil
+}
+
+type WhitelistMemberStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Active  bool         `json:"active"`
+}
+
+func (wm WhitelistMemberStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(WhitelistMemberStateValueJSONMarshaler{
+		BaseHinter: wm.BaseHinter,
+		Account:    wm.account,
+		Active:     wm.active,
+	})
+}
+
+type WhitelistMemberStateValueJSONUnmarshaler struct {
+	Account string `json:"account"`
+	Active  bool   `json:"active"`
+}
+
+func (wm *WhitelistMemberStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of WhitelistMemberStateValue")
+
+	var u WhitelistMemberStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	switch a, err := base.DecodeAddress(u.Account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		wm.account = a
+	}
+	wm.active = u.Active
+
+	return nil
+}
+
+type VoterAllowlistMemberStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Active  bool         `json:"active"`
+}
+
+func (vm VoterAllowlistMemberStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VoterAllowlistMemberStateValueJSONMarshaler{
+		BaseHinter: vm.BaseHinter,
+		Account:    vm.account,
+		Active:     vm.active,
+	})
+}
+
+type VoterAllowlistMemberStateValueJSONUnmarshaler struct {
+	Account string `json:"account"`
+	Active  bool   `json:"active"`
+}
+
+func (vm *VoterAllowlistMemberStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of VoterAllowlistMemberStateValue")
+
+	var u VoterAllowlistMemberStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	switch a, err := base.DecodeAddress(u.Account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		vm.account = a
+	}
+	vm.active = u.Active
+
+	return nil
+}
+
+type RolesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Roles   []types.Role `json:"roles"`
+}
+
+func (rs RolesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RolesStateValueJSONMarshaler{
+		BaseHinter: rs.BaseHinter,
+		Account:    rs.account,
+		Roles:      rs.roles,
+	})
+}
+
+type RolesStateValueJSONUnmarshaler struct {
+	Account string       `json:"account"`
+	Roles   []types.Role `json:"roles"`
+}
+
+func (rs *RolesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RolesStateValue")
+
+	var u RolesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	switch a, err := base.DecodeAddress(u.Account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		rs.account = a
+	}
+	rs.roles = u.Roles
+
+	return nil
+}
+
+type RoleHoldersStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Role    types.Role `json:"role"`
+	Holders uint64     `json:"holders"`
+}
+
+func (rh RoleHoldersStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RoleHoldersStateValueJSONMarshaler{
+		BaseHinter: rh.BaseHinter,
+		Role:       rh.role,
+		Holders:    rh.holders,
+	})
+}
+
+type RoleHoldersStateValueJSONUnmarshaler struct {
+	Role    string `json:"role"`
+	Holders uint64 `json:"holders"`
+}
+
+func (rh *RoleHoldersStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RoleHoldersStateValue")
+
+	var u RoleHoldersStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	rh.role = types.Role(u.Role)
+	rh.holders = u.Holders
 
 	return nil
 }
 
 type ProposalStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Status   types.ProposalStatus `json:"status"`
-	Reason   string               `json:"reason"`
-	Proposal types.Proposal       `json:"proposal"`
-	Policy   types.Policy         `json:"policy"`
+	Status        types.ProposalStatus `json:"status"`
+	Reason        string               `json:"reason"`
+	ReasonDetail  types.ReasonDetail   `json:"reason_detail"`
+	Proposal      types.Proposal       `json:"proposal"`
+	Sequence      uint64               `json:"sequence"`
+	Amendments    []types.Amendment    `json:"amendments"`
+	Policy        types.Policy         `json:"policy"`
+	PolicyVersion uint64               `json:"policy_version"`
+	Deposit       types.Deposit        `json:"deposit"`
 }
 
 func (p ProposalStateValue) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(ProposalStateValueJSONMarshaler{
-		BaseHinter: p.BaseHinter,
-		Status:     p.Status(),
-		Reason:     p.Reason(),
-		Proposal:   p.proposal,
-		Policy:     p.policy,
+		BaseHinter:    p.BaseHinter,
+		Status:        p.Status(),
+		Reason:        p.Reason(),
+		ReasonDetail:  p.reasonDetail,
+		Proposal:      p.proposal,
+		Sequence:      p.sequence,
+		Amendments:    p.amendments,
+		Policy:        p.policy,
+		PolicyVersion: p.policyVersion,
+		Deposit:       p.deposit,
 	})
 }
 
 type ProposalStateValueJSONUnmarshaler struct {
-	Status   uint8           `json:"status"`
-	Reason   string          `json:"reason"`
-	Proposal json.RawMessage `json:"proposal"`
-	Policy   json.RawMessage `json:"policy"`
+	Status        uint8           `json:"status"`
+	Reason        string          `json:"reason"`
+	ReasonDetail  json.RawMessage `json:"reason_detail"`
+	Proposal      json.RawMessage `json:"proposal"`
+	Sequence      uint64          `json:"sequence"`
+	Amendments    json.RawMessage `json:"amendments"`
+	Policy        json.RawMessage `json:"policy"`
+	PolicyVersion uint64          `json:"policy_version"`
+	Deposit       json.RawMessage `json:"deposit"`
 }
 
 func (p *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -82,6 +324,31 @@ func (p *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 	p.status = types.ProposalStatus(u.Status)
 	p.reason = u.Reason
+	p.sequence = u.Sequence
+	p.policyVersion = u.PolicyVersion
+
+	ha, err := enc.DecodeSlice(u.Amendments)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	amendments := make([]types.Amendment, len(ha))
+	for i, hinter := range ha {
+		if a, ok := hinter.(types.Amendment); !ok {
+			return e.Wrap(errors.Errorf("expected types.Amendment, not %T", hinter))
+		} else {
+			amendments[i] = a
+		}
+	}
+	p.amendments = amendments
+
+	rd := legacyReasonDetail()
+	if !util.IsNilJSON(u.ReasonDetail) {
+		if err := rd.DecodeJSON(u.ReasonDetail, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+	p.reasonDetail = rd
 
 	if hinter, err := enc.Decode(u.Proposal); err != nil {
 		return e.Wrap(err)
@@ -103,6 +370,19 @@ func (p *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		p.policy = po
 	}
 
+	switch hinter, err := enc.Decode(u.Deposit); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		p.deposit = legacyDeposit(p.proposal, p.policy)
+	default:
+		if dp, ok := hinter.(types.Deposit); !ok {
+			return e.Wrap(errors.Errorf("expected Deposit, not %T", hinter))
+		} else {
+			p.deposit = dp
+		}
+	}
+
 	return nil
 }
 
@@ -229,3 +509,300 @@ func (vb *VotingPowerBoxStateValue) DecodeJSON(b []byte, enc encoder.Encoder) er
 
 	return nil
 }
+
+type DepositContributionsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Contributions []types.DepositContribution `json:"contributions"`
+}
+
+func (dc DepositContributionsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositContributionsStateValueJSONMarshaler{
+		BaseHinter:    dc.BaseHinter,
+		Contributions: dc.contributions,
+	})
+}
+
+type DepositContributionsStateValueJSONUnmarshaler struct {
+	Contributions json.RawMessage `json:"contributions"`
+}
+
+func (dc *DepositContributionsStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DepositContributionsStateValue")
+
+	var u DepositContributionsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Contributions)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	contributions := make([]types.DepositContribution, len(hr))
+	for i, hinter := range hr {
+		if c, ok := hinter.(types.DepositContribution); !ok {
+			return e.Wrap(errors.Errorf("expected types.DepositContribution, not %T", hinter))
+		} else if err := c.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			contributions[i] = c
+		}
+	}
+	dc.contributions = contributions
+
+	return nil
+}
+
+type VetoesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Vetoes []types.VetoInfo `json:"vetoes"`
+}
+
+func (vs VetoesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VetoesStateValueJSONMarshaler{
+		BaseHinter: vs.BaseHinter,
+		Vetoes:     vs.vetoes,
+	})
+}
+
+type VetoesStateValueJSONUnmarshaler struct {
+	Vetoes json.RawMessage `json:"vetoes"`
+}
+
+func (vs *VetoesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of VetoesStateValue")
+
+	var u VetoesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Vetoes)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	vetoes := make([]types.VetoInfo, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.VetoInfo); !ok {
+			return e.Wrap(errors.Errorf("expected types.VetoInfo, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			vetoes[i] = v
+		}
+	}
+	vs.vetoes = vetoes
+
+	return nil
+}
+
+type SponsorsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sponsors []types.SponsorInfo `json:"sponsors"`
+}
+
+func (ss SponsorsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsorsStateValueJSONMarshaler{
+		BaseHinter: ss.BaseHinter,
+		Sponsors:   ss.sponsors,
+	})
+}
+
+type SponsorsStateValueJSONUnmarshaler struct {
+	Sponsors json.RawMessage `json:"sponsors"`
+}
+
+func (ss *SponsorsStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SponsorsStateValue")
+
+	var u SponsorsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Sponsors)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	sponsors := make([]types.SponsorInfo, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.SponsorInfo); !ok {
+			return e.Wrap(errors.Errorf("expected types.SponsorInfo, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			sponsors[i] = v
+		}
+	}
+	ss.sponsors = sponsors
+
+	return nil
+}
+
+type ExecutionHistoryStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Results []types.ExecutionResult `json:"execution_results"`
+}
+
+func (eh ExecutionHistoryStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExecutionHistoryStateValueJSONMarshaler{
+		BaseHinter: eh.BaseHinter,
+		Results:    eh.results,
+	})
+}
+
+type ExecutionHistoryStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Results json.RawMessage `json:"execution_results"`
+}
+
+func (eh *ExecutionHistoryStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ExecutionHistoryStateValue")
+
+	var u ExecutionHistoryStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	eh.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	hr, err := enc.DecodeSlice(u.Results)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	results := make([]types.ExecutionResult, len(hr))
+	for i, hinter := range hr {
+		if r, ok := hinter.(types.ExecutionResult); !ok {
+			return e.Wrap(errors.Errorf("expected types.ExecutionResult, not %T", hinter))
+		} else if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			results[i] = r
+		}
+	}
+	eh.results = results
+
+	return nil
+}
+
+type StatusHistoryStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Transitions []types.StatusTransition `json:"status_transitions"`
+}
+
+func (sh StatusHistoryStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StatusHistoryStateValueJSONMarshaler{
+		BaseHinter:  sh.BaseHinter,
+		Transitions: sh.transitions,
+	})
+}
+
+type StatusHistoryStateValueJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Transitions json.RawMessage `json:"status_transitions"`
+}
+
+func (sh *StatusHistoryStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of StatusHistoryStateValue")
+
+	var u StatusHistoryStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	sh.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	hr, err := enc.DecodeSlice(u.Transitions)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	transitions := make([]types.StatusTransition, len(hr))
+	for i, hinter := range hr {
+		if r, ok := hinter.(types.StatusTransition); !ok {
+			return e.Wrap(errors.Errorf("expected types.StatusTransition, not %T", hinter))
+		} else if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			transitions[i] = r
+		}
+	}
+	sh.transitions = transitions
+
+	return nil
+}
+
+type TallyStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Tally types.Tally `json:"tally"`
+}
+
+func (ts TallyStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TallyStateValueJSONMarshaler{
+		BaseHinter: ts.BaseHinter,
+		Tally:      ts.tally,
+	})
+}
+
+type TallyStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint       `json:"_hint"`
+	Tally json.RawMessage `json:"tally"`
+}
+
+func (ts *TallyStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of TallyStateValue")
+
+	var u TallyStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ts.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var tally types.Tally
+	if err := tally.DecodeJSON(u.Tally, enc); err != nil {
+		return e.Wrap(err)
+	}
+	ts.tally = tally
+
+	return nil
+}
+
+type LockedDepositStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Amount ctypes.Amount `json:"amount"`
+}
+
+func (ld LockedDepositStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockedDepositStateValueJSONMarshaler{
+		BaseHinter: ld.BaseHinter,
+		Amount:     ld.amount,
+	})
+}
+
+type LockedDepositStateValueJSONUnmarshaler struct {
+	Amount json.RawMessage `json:"amount"`
+}
+
+func (ld *LockedDepositStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of LockedDepositStateValue")
+
+	var u LockedDepositStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if hinter, err := enc.Decode(u.Amount); err != nil {
+		return e.Wrap(err)
+	} else if am, ok := hinter.(ctypes.Amount); !ok {
+		return e.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		ld.amount = am
+	}
+
+	return nil
+}
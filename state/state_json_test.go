@@ -0,0 +1,165 @@
+package state
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/dao-model/types"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	jsonenc "github.com/imfact-labs/mitum2/util/encoder/json"
+)
+
+func newTestJSONEncoder(t *testing.T) *jsonenc.Encoder {
+	enc := jsonenc.NewEncoder()
+
+	for _, d := range []encoder.DecodeDetail{
+		{Hint: ctypes.AddressHint, Instance: ctypes.Address{}},
+		{Hint: ctypes.AmountHint, Instance: ctypes.Amount{}},
+		{Hint: types.WhitelistHint, Instance: types.Whitelist{}},
+		{Hint: types.DepositRuleHint, Instance: types.DepositRule{}},
+		{Hint: types.GuardiansHint, Instance: types.Guardians{}},
+		{Hint: types.ReviewersHint, Instance: types.Reviewers{}},
+		{Hint: types.ExecutorsHint, Instance: types.Executors{}},
+		{Hint: types.PolicyBoundsHint, Instance: types.PolicyBounds{}},
+		{Hint: types.PolicyHint, Instance: types.Policy{}},
+		{Hint: types.DesignHint, Instance: types.Design{}},
+		{Hint: types.TransferCalldataHint, Instance: types.TransferCallData{}},
+		{Hint: types.CryptoProposalHint, Instance: types.CryptoProposal{}},
+		{Hint: DesignStateValueHint, Instance: DesignStateValue{}},
+		{Hint: ProposalStateValueHint, Instance: ProposalStateValue{}},
+	} {
+		if err := enc.Add(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return enc
+}
+
+// legacyJSON marshals v and drops the keys, like a value written before the keys were added.
+func legacyJSON(t *testing.T, v interface{}, keys ...string) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range keys {
+		delete(m, k)
+	}
+
+	b, err = json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func newTestAddress(h string) ctypes.Address {
+	var buf [42]byte
+	copy(buf[:], "0x"+h)
+
+	return ctypes.NewAddress(string(ctypes.ChecksumHex(buf)))
+}
+
+func newTestPolicy() types.Policy {
+	return types.NewPolicy(
+		ctypes.CurrencyID("MCC"),
+		common.NewBig(10),
+		ctypes.NewAmount(common.NewBig(3), ctypes.CurrencyID("MCC")),
+		types.NewWhitelist(false, nil),
+		10, 10, 10, 10, 10, 10,
+		types.PercentRatio(30), types.PercentRatio(50),
+		types.NewDepositRule(types.DepositKeep, types.DepositKeep, types.DepositKeep, types.DepositKeep),
+		0,
+		common.ZeroBig,
+		types.NewGuardians(nil, 0, common.ZeroBig),
+		types.PercentRatio(0),
+		0, 0, 0,
+		types.NewReviewers(nil),
+		false,
+		types.PeriodUnitSecond,
+		0, 0, 0, 0,
+		types.NewExecutors(nil),
+	)
+}
+
+func TestDesignStateValueDecodeLegacyJSON(t *testing.T) {
+	enc := newTestJSONEncoder(t)
+
+	de := NewDesignStateValue(
+		types.NewDesign(types.DAOOption("crypto"), newTestPolicy(), types.NewUnboundedPolicyBounds()),
+		false, 0, 0, nil,
+	)
+
+	hinter, err := enc.Decode(legacyJSON(t, de, "admin_renounced", "policy_version", "height", "fact_hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dv, ok := hinter.(DesignStateValue)
+	if !ok {
+		t.Fatalf("expected DesignStateValue, not %T", hinter)
+	}
+
+	if err := dv.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if dv.AdminRenounced() || dv.PolicyVersion() != 0 {
+		t.Fatal("expected the admin not renounced and the first policy version")
+	}
+
+	// the hash of the state value must not panic on the missing fact hash.
+	_ = dv.HashBytes()
+}
+
+func TestProposalStateValueDecodeLegacyJSON(t *testing.T) {
+	enc := newTestJSONEncoder(t)
+
+	proposer := newTestAddress("1f0a8b2c3d4e5f60718293a4b5c6d7e8f9012345")
+	receiver := newTestAddress("a0b1c2d3e4f5061728394a5b6c7d8e9f00112233")
+	po := newTestPolicy()
+
+	pv := NewProposalStateValue(
+		types.Proposed,
+		"",
+		types.NewReasonDetail(types.ReasonProposed),
+		types.NewCryptoProposal(
+			proposer, 1,
+			types.NewTransferCallData(proposer, receiver, ctypes.NewAmount(common.NewBig(1), ctypes.CurrencyID("MCC"))),
+			false, nil,
+		),
+		0,
+		nil,
+		po,
+		0,
+		types.NewDeposit(proposer, po.ProposalFee(), types.DepositLocked),
+	)
+
+	hinter, err := enc.Decode(legacyJSON(t, pv, "reason_detail", "sequence", "amendments", "policy_version", "deposit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, ok := hinter.(ProposalStateValue)
+	if !ok {
+		t.Fatalf("expected ProposalStateValue, not %T", hinter)
+	}
+
+	if err := p.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// the proposal fee of the proposals stored before deposits already went to the contract account.
+	if p.Deposit().IsLocked() || !p.Deposit().Depositor().Equal(proposer) {
+		t.Fatalf("expected the kept deposit of the proposer, got %v", p.Deposit())
+	}
+}
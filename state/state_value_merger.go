@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/dao-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -181,3 +182,470 @@ func (s *DelegatorsStateValueMerger) closeValue() (base.StateValue, error) {
 		rdelegators,
 	), nil
 }
+
+type ActiveProposalsStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing []string
+	add      []string
+	remove   []string
+	sync.Mutex
+}
+
+func NewActiveProposalsStateValueMerger(height base.Height, key string, st base.State) *ActiveProposalsStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &ActiveProposalsStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		s.existing = nst.Value().(ActiveProposalsStateValue).proposalIDs //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *ActiveProposalsStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddActiveProposalStateValue:
+		s.add = append(s.add, t.ProposalID)
+	case RemoveActiveProposalStateValue:
+		s.remove = append(s.remove, t.ProposalID)
+	default:
+		return errors.Errorf("unsupported active proposals state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *ActiveProposalsStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close ActiveProposalsStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *ActiveProposalsStateValueMerger) closeValue() (base.StateValue, error) {
+	removed := make(map[string]struct{}, len(s.remove))
+	for i := range s.remove {
+		removed[s.remove[i]] = struct{}{}
+	}
+
+	var npids []string
+	for _, pids := range [][]string{s.existing, s.add} {
+		for _, pid := range pids {
+			if _, found := removed[pid]; !found {
+				npids = append(npids, pid)
+			}
+		}
+	}
+
+	rpids, _ := util.RemoveDuplicatedSlice(npids, func(pid string) (string, error) { return pid, nil })
+	sort.Strings(rpids)
+
+	return NewActiveProposalsStateValue(
+		rpids,
+	), nil
+}
+
+type VetoesStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing []types.VetoInfo
+	add      []types.VetoInfo
+	sync.Mutex
+}
+
+func NewVetoesStateValueMerger(height base.Height, key string, st base.State) *VetoesStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &VetoesStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		s.existing = nst.Value().(VetoesStateValue).vetoes //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *VetoesStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case VetoesStateValue:
+		s.add = append(s.add, t.vetoes...)
+	default:
+		return errors.Errorf("unsupported vetoes state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *VetoesStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close VetoesStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *VetoesStateValueMerger) closeValue() (base.StateValue, error) {
+	nvetoes := append(append([]types.VetoInfo{}, s.existing...), s.add...)
+
+	// NOTE a guardian vetoes once; the earlier veto is kept.
+	rvetoes, _ := util.RemoveDuplicatedSlice(nvetoes, func(v types.VetoInfo) (string, error) { return v.Guardian().String(), nil })
+
+	return NewVetoesStateValue(
+		rvetoes,
+	), nil
+}
+
+type LockedDepositStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing ctypes.Amount
+	add      common.Big
+	remove   common.Big
+	sync.Mutex
+}
+
+func NewLockedDepositStateValueMerger(
+	height base.Height, key string, cid ctypes.CurrencyID, st base.State,
+) *LockedDepositStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &LockedDepositStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+		existing:             ctypes.NewAmount(common.ZeroBig, cid),
+		add:                  common.ZeroBig,
+		remove:               common.ZeroBig,
+	}
+
+	if nst.Value() != nil {
+		s.existing = nst.Value().(LockedDepositStateValue).amount //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *LockedDepositStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case LockDepositStateValue:
+		s.add = s.add.Add(t.Amount.Big())
+	case UnlockDepositStateValue:
+		s.remove = s.remove.Add(t.Amount.Big())
+	default:
+		return errors.Errorf("unsupported locked deposit state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *LockedDepositStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close LockedDepositStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *LockedDepositStateValueMerger) closeValue() (base.StateValue, error) {
+	total := s.existing.Big().Add(s.add)
+	if total.Compare(s.remove) < 0 {
+		return nil, errors.Errorf("unlocked deposits, %v over locked deposits, %v", s.remove, total)
+	}
+
+	return NewLockedDepositStateValue(
+		ctypes.NewAmount(total.Sub(s.remove), s.existing.Currency()),
+	), nil
+}
+
+// DepositContributionsStateValueMerger merges the contributions of the deposits to a proposal in a
+// block, so the deposits of different contributors do not overwrite each other.
+type DepositContributionsStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing []types.DepositContribution
+	add      []types.DepositContribution
+	sync.Mutex
+}
+
+func NewDepositContributionsStateValueMerger(
+	height base.Height, key string, st base.State,
+) *DepositContributionsStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &DepositContributionsStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		s.existing = nst.Value().(DepositContributionsStateValue).contributions //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *DepositContributionsStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddDepositContributionStateValue:
+		s.add = append(s.add, t.Contribution)
+	default:
+		return errors.Errorf("unsupported deposit contributions state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *DepositContributionsStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close DepositContributionsStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *DepositContributionsStateValueMerger) closeValue() (base.StateValue, error) {
+	contributions := make([]types.DepositContribution, len(s.existing))
+	copy(contributions, s.existing)
+
+	indices := map[string]int{}
+	for i := range contributions {
+		indices[contributions[i].Contributor().String()] = i
+	}
+
+	for _, c := range s.add {
+		i, found := indices[c.Contributor().String()]
+		if !found {
+			indices[c.Contributor().String()] = len(contributions)
+			contributions = append(contributions, c)
+
+			continue
+		}
+
+		if contributions[i].Amount().Currency() != c.Amount().Currency() {
+			return nil, errors.Errorf("contribution currency, %q != %q", c.Amount().Currency(), contributions[i].Amount().Currency())
+		}
+
+		contributions[i] = types.NewDepositContribution(
+			c.Contributor(),
+			ctypes.NewAmount(contributions[i].Amount().Big().Add(c.Amount().Big()), c.Amount().Currency()),
+		)
+	}
+
+	return NewDepositContributionsStateValue(contributions), nil
+}
+
+// ProposalDepositStateValueMerger adds the deposits in a block to the pending deposit proposal and
+// moves it to the proposed status once the deposits reach the deposit target.
+type ProposalDepositStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing *ProposalStateValue
+	add      common.Big
+	sync.Mutex
+}
+
+func NewProposalDepositStateValueMerger(
+	height base.Height, key string, st base.State,
+) *ProposalDepositStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &ProposalDepositStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+		add:                  common.ZeroBig,
+	}
+
+	if nst.Value() != nil {
+		p := nst.Value().(ProposalStateValue) //nolint:forcetypeassert //...
+		s.existing = &p
+	}
+
+	return s
+}
+
+func (s *ProposalDepositStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddDepositStateValue:
+		s.add = s.add.Add(t.Amount.Big())
+	default:
+		return errors.Errorf("unsupported proposal deposit state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *ProposalDepositStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close ProposalDepositStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *ProposalDepositStateValueMerger) closeValue() (base.StateValue, error) {
+	p := s.existing
+	switch {
+	case p == nil:
+		return nil, errors.Errorf("proposal not found")
+	case p.status != types.PendingDeposit:
+		return nil, errors.Errorf("proposal is %q, not %q", p.status, types.PendingDeposit)
+	}
+
+	total := p.deposit.Amount().Big().Add(s.add)
+	deposit := types.NewDeposit(
+		p.deposit.Depositor(), ctypes.NewAmount(total, p.deposit.Amount().Currency()), types.DepositLocked)
+
+	status, code, reason := types.PendingDeposit, types.ReasonDepositPending, "waiting for deposit"
+	if total.Compare(p.policy.DepositTarget()) >= 0 {
+		status, code, reason = types.Proposed, types.ReasonDepositTargetReached, "deposit target reached"
+	}
+
+	return NewProposalStateValue(
+		status, reason, types.NewReasonDetail(code),
+		p.proposal, p.sequence, p.amendments, p.policy, p.policyVersion, deposit,
+	), nil
+}
+
+// DepositStatusHistoryStateValueMerger records the status transition of the pending deposit
+// proposal by the deposit which reaches the deposit target in a block. The deposits are merged in
+// the order of the operations, so the same deposit reaches the target on every node.
+type DepositStatusHistoryStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing  []types.StatusTransition
+	deposited common.Big
+	target    common.Big
+	reached   util.Hash
+	sync.Mutex
+}
+
+// NewDepositStatusHistoryStateValueMerger returns the merger of the proposal which has deposited
+// before the block out of the deposit target.
+func NewDepositStatusHistoryStateValueMerger(
+	height base.Height, key string, st base.State, deposited, target common.Big,
+) *DepositStatusHistoryStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &DepositStatusHistoryStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+		deposited:            deposited,
+		target:               target,
+	}
+
+	if nst.Value() != nil {
+		s.existing = nst.Value().(StatusHistoryStateValue).transitions //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *DepositStatusHistoryStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddDepositStateValue:
+		if s.reached == nil && s.deposited.Compare(s.target) < 0 {
+			if s.deposited = s.deposited.Add(t.Amount.Big()); s.deposited.Compare(s.target) >= 0 {
+				s.reached = t.FactHash
+			}
+		}
+	default:
+		return errors.Errorf("unsupported status history state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *DepositStatusHistoryStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.BaseStateValueMerger.SetValue(s.closeValue())
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *DepositStatusHistoryStateValueMerger) closeValue() base.StateValue {
+	transitions := make([]types.StatusTransition, len(s.existing), len(s.existing)+1)
+	copy(transitions, s.existing)
+
+	if s.reached != nil {
+		transitions = append(transitions, types.NewStatusTransition(
+			types.PendingDeposit, types.Proposed, s.Height(), s.reached, types.ReasonDepositTargetReached))
+	}
+
+	return NewStatusHistoryStateValue(transitions)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+// MaxProposalAmendments is the maximum number of amendments of a proposal.
+const MaxProposalAmendments = 10
+
+var AmendmentHint = hint.MustNewHint("mitum-dao-amendment-v0.0.1")
+
+// Amendment is one entry of the amendment history of proposal;
+// it keeps the proposal body replaced by the amendment.
+type Amendment struct {
+	hint.BaseHinter
+	previous Proposal
+	height   base.Height
+	factHash util.Hash
+}
+
+func NewAmendment(previous Proposal, height base.Height, factHash util.Hash) Amendment {
+	return Amendment{
+		BaseHinter: hint.NewBaseHinter(AmendmentHint),
+		previous:   previous,
+		height:     height,
+		factHash:   factHash,
+	}
+}
+
+func (a Amendment) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid Amendment")
+
+	if err := util.CheckIsValiders(nil, false, a.BaseHinter, a.previous, a.height, a.factHash); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (a Amendment) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		a.previous.Bytes(),
+		a.height.Bytes(),
+		a.factHash.Bytes(),
+	)
+}
+
+func (a Amendment) Previous() Proposal {
+	return a.previous
+}
+
+func (a Amendment) Height() base.Height {
+	return a.height
+}
+
+func (a Amendment) FactHash() util.Hash {
+	return a.factHash
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (a Amendment) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     a.Hint().String(),
+			"previous":  a.previous,
+			"height":    a.height,
+			"fact_hash": a.factHash.String(),
+		},
+	)
+}
+
+type AmendmentBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Previous bson.Raw `bson:"previous"`
+	Height   int64    `bson:"height"`
+	FactHash string   `bson:"fact_hash"`
+}
+
+func (a *Amendment) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Amendment")
+
+	var u AmendmentBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	a.BaseHinter = hint.NewBaseHinter(ht)
+	a.height = base.Height(u.Height)
+	a.factHash = valuehash.NewBytesFromString(u.FactHash)
+
+	if hinter, err := enc.Decode(u.Previous); err != nil {
+		return e.Wrap(err)
+	} else if pr, ok := hinter.(Proposal); !ok {
+		return e.Wrap(errors.Errorf("expected Proposal, not %T", hinter))
+	} else {
+		a.previous = pr
+	}
+
+	return nil
+}
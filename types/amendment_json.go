@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+type AmendmentJSONMarshaler struct {
+	hint.BaseHinter
+	Previous Proposal    `json:"previous"`
+	Height   base.Height `json:"height"`
+	FactHash util.Hash   `json:"fact_hash"`
+}
+
+func (a Amendment) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AmendmentJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		Previous:   a.previous,
+		Height:     a.height,
+		FactHash:   a.factHash,
+	})
+}
+
+type AmendmentJSONUnmarshaler struct {
+	Hint     hint.Hint             `json:"_hint"`
+	Previous json.RawMessage       `json:"previous"`
+	Height   base.Height           `json:"height"`
+	FactHash valuehash.HashDecoder `json:"fact_hash"`
+}
+
+func (a *Amendment) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Amendment")
+
+	var u AmendmentJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	a.BaseHinter = hint.NewBaseHinter(u.Hint)
+	a.height = u.Height
+	a.factHash = u.FactHash.Hash()
+
+	if hinter, err := enc.Decode(u.Previous); err != nil {
+		return e.Wrap(err)
+	} else if pr, ok := hinter.(Proposal); !ok {
+		return e.Wrap(errors.Errorf("expected Proposal, not %T", hinter))
+	} else {
+		a.previous = pr
+	}
+
+	return nil
+}
@@ -1,20 +1,24 @@
 package types
 
 import (
+	"github.com/imfact-labs/currency-model/common"
 	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
 const (
 	CalldataTransfer   = "transfer"
 	CalldataGovernance = "governance"
+	CalldataWhitelist  = "whitelist"
 )
 
 var (
 	TransferCalldataHint   = hint.MustNewHint("mitum-dao-transfer-calldata-v0.0.1")
 	GovernanceCalldataHint = hint.MustNewHint("mitum-dao-governance-calldata-v0.0.1")
+	WhitelistCalldataHint  = hint.MustNewHint("mitum-dao-whitelist-calldata-v0.0.1")
 )
 
 type CallData interface {
@@ -92,13 +96,13 @@ func (cd TransferCallData) Addresses() []base.Address {
 
 type GovernanceCallData struct {
 	hint.BaseHinter
-	policy Policy
+	patch PolicyPatch
 }
 
-func NewGovernanceCallData(policy Policy) GovernanceCallData {
+func NewGovernanceCallData(patch PolicyPatch) GovernanceCallData {
 	return GovernanceCallData{
 		BaseHinter: hint.NewBaseHinter(GovernanceCalldataHint),
-		policy:     policy,
+		patch:      patch,
 	}
 }
 
@@ -107,11 +111,12 @@ func (GovernanceCallData) Type() string {
 }
 
 func (cd GovernanceCallData) Bytes() []byte {
-	return cd.policy.Bytes()
+	return cd.patch.Bytes()
 }
 
-func (cd GovernanceCallData) Policy() Policy {
-	return cd.policy
+// Patch is applied on top of the policy of the design at execution time.
+func (cd GovernanceCallData) Patch() PolicyPatch {
+	return cd.patch
 }
 
 func (cd GovernanceCallData) IsValid([]byte) error {
@@ -119,13 +124,101 @@ func (cd GovernanceCallData) IsValid([]byte) error {
 		return err
 	}
 
-	if err := cd.policy.IsValid(nil); err != nil {
-		return util.ErrInvalid.Errorf("governance calldata - invalid policy: %v", err)
+	if err := cd.patch.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("governance calldata - invalid policy patch: %v", err)
+	}
+
+	if cd.patch.IsEmpty() {
+		return util.ErrInvalid.Errorf("governance calldata - empty policy patch")
 	}
 
 	return nil
 }
 
 func (cd GovernanceCallData) Addresses() []base.Address {
-	return cd.policy.proposerWhitelist.accounts
+	if cd.patch.proposerWhitelist == nil {
+		return nil
+	}
+
+	return cd.patch.proposerWhitelist.accounts
+}
+
+// WhitelistCallData adds and removes whitelist members of the DAO.
+type WhitelistCallData struct {
+	hint.BaseHinter
+	add    []base.Address
+	remove []base.Address
+}
+
+func NewWhitelistCallData(add, remove []base.Address) WhitelistCallData {
+	return WhitelistCallData{
+		BaseHinter: hint.NewBaseHinter(WhitelistCalldataHint),
+		add:        add,
+		remove:     remove,
+	}
+}
+
+func (WhitelistCallData) Type() string {
+	return CalldataWhitelist
+}
+
+func (cd WhitelistCallData) Bytes() []byte {
+	bs := make([][]byte, len(cd.add)+len(cd.remove))
+	for i := range cd.add {
+		bs[i] = cd.add[i].Bytes()
+	}
+
+	for i := range cd.remove {
+		bs[len(cd.add)+i] = cd.remove[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(uint64(len(cd.add))),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (cd WhitelistCallData) Add() []base.Address {
+	return cd.add
+}
+
+func (cd WhitelistCallData) Remove() []base.Address {
+	return cd.remove
+}
+
+func (cd WhitelistCallData) IsValid([]byte) error {
+	if err := cd.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cd.add) < 1 && len(cd.remove) < 1 {
+		return util.ErrInvalid.Errorf("whitelist calldata - empty accounts")
+	}
+
+	if len(cd.add) > MaxWhitelistItems || len(cd.remove) > MaxWhitelistItems {
+		return util.ErrInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("whitelist calldata - accounts over max, %d", MaxWhitelistItems)))
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range cd.Addresses() {
+		if err := a.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid whitelist calldata: %v", err)
+		}
+
+		if _, found := founds[a.String()]; found {
+			return util.ErrInvalid.Wrap(common.ErrDupVal.Wrap(
+				errors.Errorf("whitelist calldata - account %v", a)))
+		}
+		founds[a.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (cd WhitelistCallData) Addresses() []base.Address {
+	as := make([]base.Address, 0, len(cd.add)+len(cd.remove))
+	as = append(as, cd.add...)
+
+	return append(as, cd.remove...)
 }
@@ -41,17 +41,50 @@ func (cd *TransferCallData) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	return cd.unpack(enc, ht, uc.Sender, uc.Receiver, uc.Amount)
 }
 
-func (cd GovernanceCallData) MarshalBSON() ([]byte, error) {
+func (cd WhitelistCallData) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":  cd.Hint().String(),
-			"policy": cd.policy,
+			"add":    cd.add,
+			"remove": cd.remove,
+		},
+	)
+}
+
+type WhitelistCalldataBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Add    []string `bson:"add"`
+	Remove []string `bson:"remove"`
+}
+
+func (cd *WhitelistCallData) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of WhitelistCallData")
+
+	var uc WhitelistCalldataBSONUnmarshaler
+	if err := enc.Unmarshal(b, &uc); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uc.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return cd.unpack(enc, ht, uc.Add, uc.Remove)
+}
+
+func (cd GovernanceCallData) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": cd.Hint().String(),
+			"patch": cd.patch,
 		},
 	)
 }
 
 type GovernanceCalldataBSONUnmarshaler struct {
 	Hint   string   `bson:"_hint"`
+	Patch  bson.Raw `bson:"patch"`
 	Policy bson.Raw `bson:"policy"`
 }
 
@@ -68,5 +101,5 @@ func (cd *GovernanceCallData) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return cd.unpack(enc, ht, uc.Policy)
+	return cd.unpack(enc, ht, uc.Patch, uc.Policy)
 }
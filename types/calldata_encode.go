@@ -39,17 +39,60 @@ func (cd *TransferCallData) unpack(enc encoder.Encoder, ht hint.Hint, sd, rc str
 	return nil
 }
 
-func (cd *GovernanceCallData) unpack(enc encoder.Encoder, ht hint.Hint, bpo []byte) error {
+func (cd *WhitelistCallData) unpack(enc encoder.Encoder, ht hint.Hint, add, remove []string) error {
+	e := util.StringError("failed to unmarshal WhitelistCallData")
+
+	cd.BaseHinter = hint.NewBaseHinter(ht)
+
+	adds := make([]base.Address, len(add))
+	for i, ac := range add {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			adds[i] = a
+		}
+	}
+	cd.add = adds
+
+	removes := make([]base.Address, len(remove))
+	for i, ac := range remove {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			removes[i] = a
+		}
+	}
+	cd.remove = removes
+
+	return nil
+}
+
+func (cd *GovernanceCallData) unpack(enc encoder.Encoder, ht hint.Hint, bpp, bpo []byte) error {
 	e := util.StringError("failed to unmarshal GovernanceCallData")
 
 	cd.BaseHinter = hint.NewBaseHinter(ht)
 
-	if hinter, err := enc.Decode(bpo); err != nil {
+	// governance call data written before policy patches carries the whole policy.
+	if len(bpp) < 1 && 0 < len(bpo) {
+		if hinter, err := enc.Decode(bpo); err != nil {
+			return e.Wrap(err)
+		} else if po, ok := hinter.(Policy); !ok {
+			return e.Wrap(errors.Errorf("expected Policy, not %T", hinter))
+		} else {
+			cd.patch = NewPolicyPatchFromPolicy(po)
+		}
+
+		return nil
+	}
+
+	if hinter, err := enc.Decode(bpp); err != nil {
 		return e.Wrap(err)
-	} else if po, ok := hinter.(Policy); !ok {
-		return e.Wrap(errors.Errorf("expected Policy, not %T", hinter))
+	} else if pp, ok := hinter.(PolicyPatch); !ok {
+		return e.Wrap(errors.Errorf("expected PolicyPatch, not %T", hinter))
 	} else {
-		cd.policy = po
+		cd.patch = pp
 	}
 
 	return nil
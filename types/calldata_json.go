@@ -44,20 +44,52 @@ func (cd *TransferCallData) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	return cd.unpack(enc, uc.Hint, uc.Sender, uc.Receiver, uc.Amount)
 }
 
+type WhitelistCalldataJSONMarshaler struct {
+	hint.BaseHinter
+	Add    []base.Address `json:"add"`
+	Remove []base.Address `json:"remove"`
+}
+
+func (cd WhitelistCallData) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(WhitelistCalldataJSONMarshaler{
+		BaseHinter: cd.BaseHinter,
+		Add:        cd.add,
+		Remove:     cd.remove,
+	})
+}
+
+type WhitelistCalldataJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Add    []string  `json:"add"`
+	Remove []string  `json:"remove"`
+}
+
+func (cd *WhitelistCallData) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of WhitelistCallData")
+
+	var uc WhitelistCalldataJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return cd.unpack(enc, uc.Hint, uc.Add, uc.Remove)
+}
+
 type GovernanceCalldataJSONMarshaler struct {
 	hint.BaseHinter
-	Policy Policy `json:"policy"`
+	Patch PolicyPatch `json:"patch"`
 }
 
 func (cd GovernanceCallData) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(GovernanceCalldataJSONMarshaler{
 		BaseHinter: cd.BaseHinter,
-		Policy:     cd.policy,
+		Patch:      cd.patch,
 	})
 }
 
 type GovernanceCalldataJSONUnmarshaler struct {
 	Hint   hint.Hint       `json:"_hint"`
+	Patch  json.RawMessage `json:"patch"`
 	Policy json.RawMessage `json:"policy"`
 }
 
@@ -69,5 +101,5 @@ func (cd *GovernanceCallData) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return cd.unpack(enc, uc.Hint, uc.Policy)
+	return cd.unpack(enc, uc.Hint, uc.Patch, uc.Policy)
 }
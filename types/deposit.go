@@ -0,0 +1,291 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+// DepositAction decides what happens to a proposal deposit when the proposal is settled.
+type DepositAction Option
+
+const (
+	DepositRefund DepositAction = iota
+	DepositKeep
+	DepositBurn
+	NilDepositAction
+)
+
+var depositActionNames = map[DepositAction]string{
+	DepositRefund:    "refund",
+	DepositKeep:      "keep",
+	DepositBurn:      "burn",
+	NilDepositAction: "none",
+}
+
+func DepositActionFromString(s string) (DepositAction, error) {
+	for k, v := range depositActionNames {
+		if k != NilDepositAction && v == s {
+			return k, nil
+		}
+	}
+
+	return NilDepositAction, errors.Errorf("unknown deposit action, %q", s)
+}
+
+func (a DepositAction) Bytes() []byte {
+	return util.Uint8ToBytes(uint8(a))
+}
+
+func (a DepositAction) String() string {
+	if name, found := depositActionNames[a]; found {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(a))
+}
+
+func (a DepositAction) IsValid([]byte) error {
+	if NilDepositAction <= a {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("unknown deposit action, %d", a))
+	}
+
+	return nil
+}
+
+var DepositRuleHint = hint.MustNewHint("mitum-dao-deposit-rule-v0.0.1")
+
+// DepositRule is the policy part which decides the deposit action for each proposal outcome.
+// Canceled covers proposals canceled by turnout, quorum or a missed snapshot,
+// Withdrawn covers proposals canceled by the proposer and Rejected covers proposals vetoed by guardians.
+// Completed also covers completed proposals expired without execution.
+type DepositRule struct {
+	hint.BaseHinter
+	onCompleted DepositAction
+	onRejected  DepositAction
+	onCanceled  DepositAction
+	onWithdrawn DepositAction
+}
+
+func NewDepositRule(onCompleted, onRejected, onCanceled, onWithdrawn DepositAction) DepositRule {
+	return DepositRule{
+		BaseHinter:  hint.NewBaseHinter(DepositRuleHint),
+		onCompleted: onCompleted,
+		onRejected:  onRejected,
+		onCanceled:  onCanceled,
+		onWithdrawn: onWithdrawn,
+	}
+}
+
+func (dr DepositRule) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		dr.onCompleted.Bytes(),
+		dr.onRejected.Bytes(),
+		dr.onCanceled.Bytes(),
+		dr.onWithdrawn.Bytes(),
+	)
+}
+
+func (dr DepositRule) IsValid([]byte) error {
+	e := util.StringError("invalid deposit rule")
+
+	if err := util.CheckIsValiders(nil, false,
+		dr.BaseHinter,
+		dr.onCompleted,
+		dr.onRejected,
+		dr.onCanceled,
+		dr.onWithdrawn,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (dr DepositRule) OnCompleted() DepositAction {
+	return dr.onCompleted
+}
+
+func (dr DepositRule) OnRejected() DepositAction {
+	return dr.onRejected
+}
+
+func (dr DepositRule) OnCanceled() DepositAction {
+	return dr.onCanceled
+}
+
+func (dr DepositRule) OnWithdrawn() DepositAction {
+	return dr.onWithdrawn
+}
+
+// Action returns the deposit action for the settled proposal status.
+func (dr DepositRule) Action(status ProposalStatus) DepositAction {
+	switch status {
+	case Completed, Executed, Expired, ExecutionFailed:
+		return dr.onCompleted
+	case Rejected, Vetoed:
+		return dr.onRejected
+	case Canceled:
+		return dr.onCanceled
+	default:
+		return NilDepositAction
+	}
+}
+
+type DepositStatus Option
+
+const (
+	DepositLocked DepositStatus = iota
+	DepositRefunded
+	DepositKept
+	DepositBurned
+	NilDepositStatus
+)
+
+var depositStatusNames = map[DepositStatus]string{
+	DepositLocked:    "locked",
+	DepositRefunded:  "refunded",
+	DepositKept:      "kept",
+	DepositBurned:    "burned",
+	NilDepositStatus: "none",
+}
+
+func (s DepositStatus) Bytes() []byte {
+	return util.Uint8ToBytes(uint8(s))
+}
+
+func (s DepositStatus) String() string {
+	if name, found := depositStatusNames[s]; found {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(s))
+}
+
+var DepositHint = hint.MustNewHint("mitum-dao-deposit-v0.0.1")
+
+// Deposit is the proposal fee locked in the contract account until the proposal is settled.
+type Deposit struct {
+	hint.BaseHinter
+	depositor base.Address
+	amount    ctypes.Amount
+	status    DepositStatus
+}
+
+func NewDeposit(depositor base.Address, amount ctypes.Amount, status DepositStatus) Deposit {
+	return Deposit{
+		BaseHinter: hint.NewBaseHinter(DepositHint),
+		depositor:  depositor,
+		amount:     amount,
+		status:     status,
+	}
+}
+
+func (d Deposit) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		d.depositor.Bytes(),
+		d.amount.Bytes(),
+		d.status.Bytes(),
+	)
+}
+
+func (d Deposit) IsValid([]byte) error {
+	e := util.StringError("invalid deposit")
+
+	if err := util.CheckIsValiders(nil, false,
+		d.BaseHinter,
+		d.depositor,
+		d.amount,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	if NilDepositStatus <= d.status {
+		return e.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("unknown deposit status, %d", d.status)))
+	}
+
+	return nil
+}
+
+func (d Deposit) Depositor() base.Address {
+	return d.depositor
+}
+
+func (d Deposit) Amount() ctypes.Amount {
+	return d.amount
+}
+
+func (d Deposit) Status() DepositStatus {
+	return d.status
+}
+
+func (d Deposit) IsLocked() bool {
+	return d.status == DepositLocked
+}
+
+// Settled returns the deposit with the status resulting from the action.
+func (d Deposit) Settled(action DepositAction) Deposit {
+	switch action {
+	case DepositRefund:
+		d.status = DepositRefunded
+	case DepositBurn:
+		d.status = DepositBurned
+	default:
+		d.status = DepositKept
+	}
+
+	return d
+}
+
+var DepositContributionHint = hint.MustNewHint("mitum-dao-deposit-contribution-v0.0.1")
+
+// DepositContribution is the amount an account added to a crowdfunded proposal deposit.
+type DepositContribution struct {
+	hint.BaseHinter
+	contributor base.Address
+	amount      ctypes.Amount
+}
+
+func NewDepositContribution(contributor base.Address, amount ctypes.Amount) DepositContribution {
+	return DepositContribution{
+		BaseHinter:  hint.NewBaseHinter(DepositContributionHint),
+		contributor: contributor,
+		amount:      amount,
+	}
+}
+
+func (c DepositContribution) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		c.contributor.Bytes(),
+		c.amount.Bytes(),
+	)
+}
+
+func (c DepositContribution) IsValid([]byte) error {
+	e := util.StringError("invalid deposit contribution")
+
+	if err := util.CheckIsValiders(nil, false,
+		c.BaseHinter,
+		c.contributor,
+		c.amount,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !c.amount.Big().OverZero() {
+		return e.Wrap(common.ErrValOOR.Wrap(errors.Errorf("contribution amount must be bigger than zero, got %v", c.amount.Big())))
+	}
+
+	return nil
+}
+
+func (c DepositContribution) Contributor() base.Address {
+	return c.contributor
+}
+
+func (c DepositContribution) Amount() ctypes.Amount {
+	return c.amount
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (dr DepositRule) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        dr.Hint().String(),
+			"on_completed": dr.onCompleted,
+			"on_rejected":  dr.onRejected,
+			"on_canceled":  dr.onCanceled,
+			"on_withdrawn": dr.onWithdrawn,
+		},
+	)
+}
+
+type DepositRuleBSONUnmarshaler struct {
+	Hint        string `bson:"_hint"`
+	OnCompleted uint8  `bson:"on_completed"`
+	OnRejected  uint8  `bson:"on_rejected"`
+	OnCanceled  uint8  `bson:"on_canceled"`
+	OnWithdrawn uint8  `bson:"on_withdrawn"`
+}
+
+func (dr *DepositRule) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DepositRule")
+
+	var u DepositRuleBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return dr.unpack(enc, ht, u.OnCompleted, u.OnRejected, u.OnCanceled, u.OnWithdrawn)
+}
+
+func (d Deposit) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     d.Hint().String(),
+			"depositor": d.depositor,
+			"amount":    d.amount,
+			"status":    d.status,
+		},
+	)
+}
+
+type DepositBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Depositor string   `bson:"depositor"`
+	Amount    bson.Raw `bson:"amount"`
+	Status    uint8    `bson:"status"`
+}
+
+func (d *Deposit) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Deposit")
+
+	var u DepositBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return d.unpack(enc, ht, u.Depositor, u.Amount, u.Status)
+}
+
+func (c DepositContribution) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       c.Hint().String(),
+			"contributor": c.contributor,
+			"amount":      c.amount,
+		},
+	)
+}
+
+type DepositContributionBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Contributor string   `bson:"contributor"`
+	Amount      bson.Raw `bson:"amount"`
+}
+
+func (c *DepositContribution) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DepositContribution")
+
+	var u DepositContributionBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, ht, u.Contributor, u.Amount)
+}
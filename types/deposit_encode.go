@@ -0,0 +1,67 @@
+package types
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (dr *DepositRule) unpack(_ encoder.Encoder, ht hint.Hint, oc, or, ocl, ow uint8) error {
+	dr.BaseHinter = hint.NewBaseHinter(ht)
+	dr.onCompleted = DepositAction(oc)
+	dr.onRejected = DepositAction(or)
+	dr.onCanceled = DepositAction(ocl)
+	dr.onWithdrawn = DepositAction(ow)
+
+	return nil
+}
+
+func (d *Deposit) unpack(enc encoder.Encoder, ht hint.Hint, da string, bam []byte, st uint8) error {
+	e := util.StringError("failed to unmarshal Deposit")
+
+	d.BaseHinter = hint.NewBaseHinter(ht)
+	d.status = DepositStatus(st)
+
+	switch a, err := base.DecodeAddress(da, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		d.depositor = a
+	}
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return e.Wrap(err)
+	} else if am, ok := hinter.(ctypes.Amount); !ok {
+		return e.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		d.amount = am
+	}
+
+	return nil
+}
+
+func (c *DepositContribution) unpack(enc encoder.Encoder, ht hint.Hint, ca string, bam []byte) error {
+	e := util.StringError("failed to unmarshal DepositContribution")
+
+	c.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		c.contributor = a
+	}
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return e.Wrap(err)
+	} else if am, ok := hinter.(ctypes.Amount); !ok {
+		return e.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		c.amount = am
+	}
+
+	return nil
+}
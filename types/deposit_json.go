@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/json"
+
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type DepositRuleJSONMarshaler struct {
+	hint.BaseHinter
+	OnCompleted DepositAction `json:"on_completed"`
+	OnRejected  DepositAction `json:"on_rejected"`
+	OnCanceled  DepositAction `json:"on_canceled"`
+	OnWithdrawn DepositAction `json:"on_withdrawn"`
+}
+
+func (dr DepositRule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositRuleJSONMarshaler{
+		BaseHinter:  dr.BaseHinter,
+		OnCompleted: dr.onCompleted,
+		OnRejected:  dr.onRejected,
+		OnCanceled:  dr.onCanceled,
+		OnWithdrawn: dr.onWithdrawn,
+	})
+}
+
+type DepositRuleJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	OnCompleted uint8     `json:"on_completed"`
+	OnRejected  uint8     `json:"on_rejected"`
+	OnCanceled  uint8     `json:"on_canceled"`
+	OnWithdrawn uint8     `json:"on_withdrawn"`
+}
+
+func (dr *DepositRule) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DepositRule")
+
+	var u DepositRuleJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return dr.unpack(enc, u.Hint, u.OnCompleted, u.OnRejected, u.OnCanceled, u.OnWithdrawn)
+}
+
+type DepositJSONMarshaler struct {
+	hint.BaseHinter
+	Depositor base.Address  `json:"depositor"`
+	Amount    ctypes.Amount `json:"amount"`
+	Status    DepositStatus `json:"status"`
+}
+
+func (d Deposit) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositJSONMarshaler{
+		BaseHinter: d.BaseHinter,
+		Depositor:  d.depositor,
+		Amount:     d.amount,
+		Status:     d.status,
+	})
+}
+
+type DepositJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Depositor string          `json:"depositor"`
+	Amount    json.RawMessage `json:"amount"`
+	Status    uint8           `json:"status"`
+}
+
+func (d *Deposit) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Deposit")
+
+	var u DepositJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return d.unpack(enc, u.Hint, u.Depositor, u.Amount, u.Status)
+}
+
+type DepositContributionJSONMarshaler struct {
+	hint.BaseHinter
+	Contributor base.Address  `json:"contributor"`
+	Amount      ctypes.Amount `json:"amount"`
+}
+
+func (c DepositContribution) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositContributionJSONMarshaler{
+		BaseHinter:  c.BaseHinter,
+		Contributor: c.contributor,
+		Amount:      c.amount,
+	})
+}
+
+type DepositContributionJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Contributor string          `json:"contributor"`
+	Amount      json.RawMessage `json:"amount"`
+}
+
+func (c *DepositContribution) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DepositContribution")
+
+	var u DepositContributionJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, u.Hint, u.Contributor, u.Amount)
+}
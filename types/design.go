@@ -23,17 +23,21 @@ func (op DAOOption) Bytes() []byte {
 
 var DesignHint = hint.MustNewHint("mitum-dao-design-v0.0.1")
 
+// Design is the dao option and policy. The policy bounds are set when the dao is registered
+// and the policy must stay within them.
 type Design struct {
 	hint.BaseHinter
 	option DAOOption
 	policy Policy
+	bounds PolicyBounds
 }
 
-func NewDesign(option DAOOption, policy Policy) Design {
+func NewDesign(option DAOOption, policy Policy, bounds PolicyBounds) Design {
 	return Design{
 		BaseHinter: hint.NewBaseHinter(DesignHint),
 		option:     option,
 		policy:     policy,
+		bounds:     bounds,
 	}
 }
 
@@ -42,17 +46,32 @@ func (de Design) IsValid([]byte) error {
 		de.BaseHinter,
 		de.option,
 		de.policy,
+		de.bounds,
 	); err != nil {
 		return util.ErrInvalid.Errorf("invalid Design: %v", err)
 	}
 
+	if err := de.bounds.Check(de.policy); err != nil {
+		return util.ErrInvalid.Errorf("invalid Design: %v", err)
+	}
+
 	return nil
 }
 
+// Bytes omits the bounds when they accept any policy, so the designs written before them keep
+// their hashes.
 func (de Design) Bytes() []byte {
+	if de.bounds.IsUnbounded() {
+		return util.ConcatBytesSlice(
+			de.option.Bytes(),
+			de.policy.Bytes(),
+		)
+	}
+
 	return util.ConcatBytesSlice(
 		de.option.Bytes(),
 		de.policy.Bytes(),
+		de.bounds.Bytes(),
 	)
 }
 
@@ -63,3 +82,7 @@ func (de Design) Option() DAOOption {
 func (de Design) Policy() Policy {
 	return de.policy
 }
+
+func (de Design) Bounds() PolicyBounds {
+	return de.bounds
+}
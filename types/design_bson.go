@@ -13,6 +13,7 @@ func (de Design) MarshalBSON() ([]byte, error) {
 			"_hint":  de.Hint().String(),
 			"option": de.option,
 			"policy": de.policy,
+			"bounds": de.bounds,
 		})
 }
 
@@ -20,6 +21,7 @@ type DesignBSONUnmarshaler struct {
 	Hint   string   `bson:"_hint"`
 	Option string   `bson:"option"`
 	Policy bson.Raw `bson:"policy"`
+	Bounds bson.Raw `bson:"bounds"`
 }
 
 func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -35,5 +37,5 @@ func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ht, ud.Option, ud.Policy)
+	return de.unpack(enc, ht, ud.Option, ud.Policy, ud.Bounds)
 }
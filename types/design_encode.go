@@ -7,7 +7,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, op string, bpo []byte) error {
+func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, op string, bpo, bbd []byte) error {
 	e := util.StringError("failed to ummarshal of Design")
 
 	de.BaseHinter = hint.NewBaseHinter(ht)
@@ -21,5 +21,11 @@ func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, op string, bpo []byt
 		de.policy = po
 	}
 
+	if bd, err := DecodeHinterOr(enc, bbd, NewUnboundedPolicyBounds()); err != nil {
+		return e.Wrap(err)
+	} else {
+		de.bounds = bd
+	}
+
 	return nil
 }
@@ -10,8 +10,9 @@ import (
 
 type DesignJSONMarshaler struct {
 	hint.BaseHinter
-	Option DAOOption `json:"option"`
-	Policy Policy    `json:"policy"`
+	Option DAOOption    `json:"option"`
+	Policy Policy       `json:"policy"`
+	Bounds PolicyBounds `json:"bounds"`
 }
 
 func (de Design) MarshalJSON() ([]byte, error) {
@@ -19,6 +20,7 @@ func (de Design) MarshalJSON() ([]byte, error) {
 		BaseHinter: de.BaseHinter,
 		Option:     de.option,
 		Policy:     de.policy,
+		Bounds:     de.bounds,
 	})
 }
 
@@ -26,6 +28,7 @@ type DesignJSONUnmarshaler struct {
 	Hint   hint.Hint       `json:"_hint"`
 	Option string          `json:"option"`
 	Policy json.RawMessage `json:"policy"`
+	Bounds json.RawMessage `json:"bounds"`
 }
 
 func (de *Design) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -36,5 +39,5 @@ func (de *Design) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ud.Hint, ud.Option, ud.Policy)
+	return de.unpack(enc, ud.Hint, ud.Option, ud.Policy, ud.Bounds)
 }
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var (
+	ActionResultHint    = hint.MustNewHint("mitum-dao-action-result-v0.0.1")
+	ExecutionResultHint = hint.MustNewHint("mitum-dao-execution-result-v0.0.1")
+)
+
+// ActionResult is the result of applying one action of the proposal, such as a call data.
+type ActionResult struct {
+	hint.BaseHinter
+	action  string
+	success bool
+	reason  string
+}
+
+func NewActionResult(action string, success bool, reason string) ActionResult {
+	return ActionResult{
+		BaseHinter: hint.NewBaseHinter(ActionResultHint),
+		action:     action,
+		success:    success,
+		reason:     reason,
+	}
+}
+
+func (r ActionResult) Bytes() []byte {
+	sb := make([]byte, 1)
+	if r.success {
+		sb[0] = 1
+	}
+
+	return util.ConcatBytesSlice(
+		[]byte(r.action),
+		sb,
+		[]byte(r.reason),
+	)
+}
+
+func (r ActionResult) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ActionResult")
+
+	if err := r.BaseHinter.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(r.action) == 0 {
+		return e.Errorf("empty action")
+	}
+
+	return nil
+}
+
+func (r ActionResult) Action() string {
+	return r.action
+}
+
+func (r ActionResult) Success() bool {
+	return r.success
+}
+
+func (r ActionResult) Reason() string {
+	return r.reason
+}
+
+// ExecutionResult is the result of executing the proposal at the height.
+type ExecutionResult struct {
+	hint.BaseHinter
+	height  base.Height
+	actions []ActionResult
+}
+
+func NewExecutionResult(height base.Height, actions []ActionResult) ExecutionResult {
+	return ExecutionResult{
+		BaseHinter: hint.NewBaseHinter(ExecutionResultHint),
+		height:     height,
+		actions:    actions,
+	}
+}
+
+func (r ExecutionResult) Bytes() []byte {
+	bs := make([][]byte, len(r.actions))
+	for i := range r.actions {
+		bs[i] = r.actions[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		r.height.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (r ExecutionResult) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ExecutionResult")
+
+	if err := util.CheckIsValiders(nil, false, r.BaseHinter, r.height); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range r.actions {
+		if err := r.actions[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (r ExecutionResult) Height() base.Height {
+	return r.height
+}
+
+func (r ExecutionResult) Actions() []ActionResult {
+	return r.actions
+}
+
+// Succeeded reports whether every action of the proposal is applied.
+func (r ExecutionResult) Succeeded() bool {
+	for i := range r.actions {
+		if !r.actions[i].success {
+			return false
+		}
+	}
+
+	return true
+}
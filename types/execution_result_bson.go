@@ -0,0 +1,84 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (r ActionResult) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   r.Hint().String(),
+			"action":  r.action,
+			"success": r.success,
+			"reason":  r.reason,
+		},
+	)
+}
+
+type ActionResultBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Action  string `bson:"action"`
+	Success bool   `bson:"success"`
+	Reason  string `bson:"reason"`
+}
+
+func (r *ActionResult) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ActionResult")
+
+	var u ActionResultBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.action = u.Action
+	r.success = u.Success
+	r.reason = u.Reason
+
+	return nil
+}
+
+func (r ExecutionResult) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   r.Hint().String(),
+			"height":  r.height,
+			"actions": r.actions,
+		},
+	)
+}
+
+type ExecutionResultBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Height  int64    `bson:"height"`
+	Actions bson.Raw `bson:"actions"`
+}
+
+func (r *ExecutionResult) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ExecutionResult")
+
+	var u ExecutionResultBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.unpack(enc, ht, base.Height(u.Height), u.Actions); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
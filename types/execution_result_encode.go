@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (r *ExecutionResult) unpack(enc encoder.Encoder, ht hint.Hint, h base.Height, bac []byte) error {
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.height = h
+
+	hr, err := enc.DecodeSlice(bac)
+	if err != nil {
+		return err
+	}
+
+	actions := make([]ActionResult, len(hr))
+	for i, hinter := range hr {
+		if a, ok := hinter.(ActionResult); !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected ActionResult, not %T", hinter))
+		} else {
+			actions[i] = a
+		}
+	}
+	r.actions = actions
+
+	return nil
+}
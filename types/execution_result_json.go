@@ -0,0 +1,84 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type ActionResultJSONMarshaler struct {
+	hint.BaseHinter
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Reason  string `json:"reason"`
+}
+
+func (r ActionResult) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ActionResultJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Action:     r.action,
+		Success:    r.success,
+		Reason:     r.reason,
+	})
+}
+
+type ActionResultJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Action  string    `json:"action"`
+	Success bool      `json:"success"`
+	Reason  string    `json:"reason"`
+}
+
+func (r *ActionResult) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ActionResult")
+
+	var u ActionResultJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(u.Hint)
+	r.action = u.Action
+	r.success = u.Success
+	r.reason = u.Reason
+
+	return nil
+}
+
+type ExecutionResultJSONMarshaler struct {
+	hint.BaseHinter
+	Height  base.Height    `json:"height"`
+	Actions []ActionResult `json:"actions"`
+}
+
+func (r ExecutionResult) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExecutionResultJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Height:     r.height,
+		Actions:    r.actions,
+	})
+}
+
+type ExecutionResultJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Height  base.Height     `json:"height"`
+	Actions json.RawMessage `json:"actions"`
+}
+
+func (r *ExecutionResult) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ExecutionResult")
+
+	var u ExecutionResultJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.unpack(enc, u.Hint, u.Height, u.Actions); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"fmt"
 
+	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
 )
 
 type Option uint8
@@ -29,18 +31,28 @@ const (
 	Completed
 	Rejected
 	Executed
+	PendingDeposit
+	Lapsed
+	Vetoed
+	Expired
+	ExecutionFailed
 	NilStatus
 )
 
 var proposalStatusNames = map[ProposalStatus]string{
-	Proposed:    "proposed",
-	Canceled:    "canceled",
-	PreSnapped:  "pre-snapped",
-	PostSnapped: "post-snapped",
-	Completed:   "completed",
-	Rejected:    "rejected",
-	Executed:    "executed",
-	NilStatus:   "none",
+	Proposed:        "proposed",
+	Canceled:        "canceled",
+	PreSnapped:      "pre-snapped",
+	PostSnapped:     "post-snapped",
+	Completed:       "completed",
+	Rejected:        "rejected",
+	Executed:        "executed",
+	PendingDeposit:  "pending-deposit",
+	Lapsed:          "lapsed",
+	Vetoed:          "vetoed",
+	Expired:         "expired",
+	ExecutionFailed: "execution-failed",
+	NilStatus:       "none",
 }
 
 type Period Option
@@ -51,6 +63,7 @@ func (p Period) Bytes() []byte {
 
 const (
 	PreLifeCycle Period = iota
+	DepositCollection
 	ProposalReview
 	PreSnapshot
 	Registration
@@ -58,5 +71,113 @@ const (
 	PostSnapshot
 	ExecutionDelay
 	Execute
+	ExecutionExpired
 	NilPeriod
 )
+
+type ReasonCode Option
+
+func (r ReasonCode) Bytes() []byte {
+	return util.Uint8ToBytes(uint8(r))
+}
+
+func (r ReasonCode) String() string {
+	if name, found := reasonCodeNames[r]; found {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(r))
+}
+
+const (
+	ReasonProposed ReasonCode = iota
+	ReasonDepositPending
+	ReasonDepositTargetReached
+	ReasonDepositPeriodEnded
+	ReasonCanceledByProposer
+	ReasonTurnoutReached
+	ReasonTurnoutNotReached
+	ReasonTurnoutWaived
+	ReasonPreSnapMissed
+	ReasonQuorumNotReached
+	ReasonApproved
+	ReasonNotApproved
+	ReasonObjectionExceeded
+	ReasonObjectionNotExceeded
+	ReasonVetoed
+	ReasonNotExecutable
+	ReasonExecutionSucceeded
+	ReasonExecutionFailed
+	ReasonExecutionExpired
+	ReasonDependencyFailed
+	ReasonSponsorshipMissing
+	ReasonRejectedByReviewer
+	NilReason
+)
+
+var reasonCodeNames = map[ReasonCode]string{
+	ReasonProposed:             "proposed",
+	ReasonDepositPending:       "deposit-pending",
+	ReasonDepositTargetReached: "deposit-target-reached",
+	ReasonDepositPeriodEnded:   "deposit-period-ended",
+	ReasonCanceledByProposer:   "canceled-by-proposer",
+	ReasonTurnoutReached:       "turnout-reached",
+	ReasonTurnoutNotReached:    "turnout-not-reached",
+	ReasonTurnoutWaived:        "turnout-waived",
+	ReasonPreSnapMissed:        "pre-snap-missed",
+	ReasonQuorumNotReached:     "quorum-not-reached",
+	ReasonApproved:             "approved",
+	ReasonNotApproved:          "not-approved",
+	ReasonObjectionExceeded:    "objection-exceeded",
+	ReasonObjectionNotExceeded: "objection-not-exceeded",
+	ReasonVetoed:               "vetoed",
+	ReasonNotExecutable:        "not-executable",
+	ReasonExecutionSucceeded:   "execution-succeeded",
+	ReasonExecutionFailed:      "execution-failed",
+	ReasonExecutionExpired:     "execution-expired",
+	ReasonDependencyFailed:     "dependency-failed",
+	ReasonSponsorshipMissing:   "sponsorship-missing",
+	ReasonRejectedByReviewer:   "rejected-by-reviewer",
+	NilReason:                  "none",
+}
+
+// PeriodUnit is the unit of the policy periods and of the proposal start time.
+type PeriodUnit Option
+
+const (
+	PeriodUnitSecond PeriodUnit = iota
+	PeriodUnitHeight
+)
+
+var periodUnitNames = map[PeriodUnit]string{
+	PeriodUnitSecond: "second",
+	PeriodUnitHeight: "height",
+}
+
+func PeriodUnitFromString(s string) (PeriodUnit, error) {
+	for k, v := range periodUnitNames {
+		if v == s {
+			return k, nil
+		}
+	}
+
+	return PeriodUnitSecond, errors.Errorf("unknown period unit, %q", s)
+}
+
+func (u PeriodUnit) Bytes() []byte {
+	return util.Uint8ToBytes(uint8(u))
+}
+
+func (u PeriodUnit) String() string {
+	if name, found := periodUnitNames[u]; found {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(u))
+}
+
+func (u PeriodUnit) IsValid([]byte) error {
+	if _, found := periodUnitNames[u]; !found {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("unknown period unit, %d", uint8(u)))
+	}
+
+	return nil
+}
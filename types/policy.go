@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"time"
+
 	"github.com/imfact-labs/currency-model/common"
 	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
@@ -11,8 +14,18 @@ import (
 
 var WhitelistHint = hint.MustNewHint("mitum-dao-whitelist-v0.0.1")
 
-const MaxWhitelist = 10
+const (
+	MaxWhitelist = 10
+	// MaxWhitelistItems is the number of accounts a whitelist operation or call data can add
+	// or remove at once.
+	MaxWhitelistItems = 20
+	// MaxVoterAllowlistItems is the number of accounts a voter allowlist operation can add
+	// or remove at once.
+	MaxVoterAllowlistItems = 20
+)
 
+// Whitelist is the proposer whitelist of the policy. When it is active, an account can propose
+// if it is one of the accounts or a whitelist member managed by the whitelist operations.
 type Whitelist struct {
 	hint.BaseHinter
 	active   bool
@@ -90,6 +103,267 @@ func (wl Whitelist) IsExist(a base.Address) bool {
 	return false
 }
 
+var GuardiansHint = hint.MustNewHint("mitum-dao-guardians-v0.0.1")
+
+const MaxGuardians = 10
+
+// Guardians is the account set which can veto a completed security-critical proposal during
+// the execution delay period. A crypto proposal is security-critical if it carries governance
+// or whitelist call data, or transfers at least transferLimit. The guardian set is inactive without accounts.
+type Guardians struct {
+	hint.BaseHinter
+	accounts      []base.Address
+	threshold     uint
+	transferLimit common.Big
+}
+
+func NewGuardians(accounts []base.Address, threshold uint, transferLimit common.Big) Guardians {
+	return Guardians{
+		BaseHinter:    hint.NewBaseHinter(GuardiansHint),
+		accounts:      accounts,
+		threshold:     threshold,
+		transferLimit: transferLimit,
+	}
+}
+
+func (gd Guardians) Bytes() []byte {
+	ads := make([][]byte, len(gd.accounts))
+	for i := range gd.accounts {
+		ads[i] = gd.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(ads...),
+		util.UintToBytes(gd.threshold),
+		gd.transferLimit.Bytes(),
+	)
+}
+
+func (gd Guardians) IsValid([]byte) error {
+	e := util.StringError("invalid guardians")
+
+	if err := util.CheckIsValiders(nil, false, gd.BaseHinter, gd.transferLimit); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(gd.accounts) > MaxGuardians {
+		return e.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("guardian accounts over max, %d > %d", len(gd.accounts), MaxGuardians)))
+	}
+
+	if uint(len(gd.accounts)) < gd.threshold || (0 < len(gd.accounts) && gd.threshold < 1) {
+		return e.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("guardian threshold must be between 1 and %d, got %d", len(gd.accounts), gd.threshold)))
+	}
+
+	duplicated := make(map[string]struct{})
+	for _, ac := range gd.accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+		if _, found := duplicated[ac.String()]; found {
+			return e.Wrap(common.ErrDupVal.Wrap(errors.Errorf("guardian account %v", ac)))
+		}
+		duplicated[ac.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (gd Guardians) Active() bool {
+	return 0 < len(gd.accounts)
+}
+
+func (gd Guardians) Accounts() []base.Address {
+	return gd.accounts
+}
+
+func (gd Guardians) Threshold() uint {
+	return gd.threshold
+}
+
+func (gd Guardians) TransferLimit() common.Big {
+	return gd.transferLimit
+}
+
+func (gd Guardians) IsExist(a base.Address) bool {
+	for _, ac := range gd.accounts {
+		if ac.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Guards reports whether the proposal can be vetoed by the guardians.
+func (gd Guardians) Guards(proposal Proposal) bool {
+	if !gd.Active() {
+		return false
+	}
+
+	p, ok := proposal.(CryptoProposal)
+	if !ok {
+		return false
+	}
+
+	switch cd := p.CallData().(type) {
+	case GovernanceCallData, WhitelistCallData:
+		return true
+	case TransferCallData:
+		return cd.Amount().Big().Compare(gd.transferLimit) >= 0
+	default:
+		return false
+	}
+}
+
+var ReviewersHint = hint.MustNewHint("mitum-dao-reviewers-v0.0.1")
+
+const (
+	MaxReviewers       = 10
+	MaxRejectReasonLen = 256
+)
+
+// Reviewers is the committee whose members can reject a proposal during the proposal
+// review period, for spam or invalid call data. The committee is inactive without accounts.
+type Reviewers struct {
+	hint.BaseHinter
+	accounts []base.Address
+}
+
+func NewReviewers(accounts []base.Address) Reviewers {
+	return Reviewers{
+		BaseHinter: hint.NewBaseHinter(ReviewersHint),
+		accounts:   accounts,
+	}
+}
+
+func (rv Reviewers) Bytes() []byte {
+	ads := make([][]byte, len(rv.accounts))
+	for i := range rv.accounts {
+		ads[i] = rv.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(ads...)
+}
+
+func (rv Reviewers) IsValid([]byte) error {
+	e := util.StringError("invalid reviewers")
+
+	if err := util.CheckIsValiders(nil, false, rv.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(rv.accounts) > MaxReviewers {
+		return e.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("reviewer accounts over max, %d > %d", len(rv.accounts), MaxReviewers)))
+	}
+
+	duplicated := make(map[string]struct{})
+	for _, ac := range rv.accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+		if _, found := duplicated[ac.String()]; found {
+			return e.Wrap(common.ErrDupVal.Wrap(errors.Errorf("reviewer account %v", ac)))
+		}
+		duplicated[ac.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (rv Reviewers) Active() bool {
+	return 0 < len(rv.accounts)
+}
+
+func (rv Reviewers) Accounts() []base.Address {
+	return rv.accounts
+}
+
+func (rv Reviewers) IsExist(a base.Address) bool {
+	for _, ac := range rv.accounts {
+		if ac.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var ExecutorsHint = hint.MustNewHint("mitum-dao-executors-v0.0.1")
+
+const MaxExecutors = 10
+
+// Executors is the account set which, with the executor role holders, alone can pre-snap,
+// post-snap and execute proposals. Anyone can run the lifecycle operations while the set is
+// inactive without accounts and nobody holds the executor role. After the registration, only
+// a governance proposal can change it.
+type Executors struct {
+	hint.BaseHinter
+	accounts []base.Address
+}
+
+func NewExecutors(accounts []base.Address) Executors {
+	return Executors{
+		BaseHinter: hint.NewBaseHinter(ExecutorsHint),
+		accounts:   accounts,
+	}
+}
+
+func (ex Executors) Bytes() []byte {
+	ads := make([][]byte, len(ex.accounts))
+	for i := range ex.accounts {
+		ads[i] = ex.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(ads...)
+}
+
+func (ex Executors) IsValid([]byte) error {
+	e := util.StringError("invalid executors")
+
+	if err := util.CheckIsValiders(nil, false, ex.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(ex.accounts) > MaxExecutors {
+		return e.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("executor accounts over max, %d > %d", len(ex.accounts), MaxExecutors)))
+	}
+
+	duplicated := make(map[string]struct{})
+	for _, ac := range ex.accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+		if _, found := duplicated[ac.String()]; found {
+			return e.Wrap(common.ErrDupVal.Wrap(errors.Errorf("executor account %v", ac)))
+		}
+		duplicated[ac.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ex Executors) Active() bool {
+	return 0 < len(ex.accounts)
+}
+
+func (ex Executors) Accounts() []base.Address {
+	return ex.accounts
+}
+
+func (ex Executors) IsExist(a base.Address) bool {
+	for _, ac := range ex.accounts {
+		if ac.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
 var PolicyHint = hint.MustNewHint("mitum-dao-policy-v0.0.1")
 
 type Policy struct {
@@ -106,6 +380,22 @@ type Policy struct {
 	executionDelayPeriod uint64
 	turnout              PercentRatio
 	quorum               PercentRatio
+	depositRule          DepositRule
+	depositPeriod        uint64
+	depositTarget        common.Big
+	guardians            Guardians
+	objectionThreshold   PercentRatio
+	executionGracePeriod uint64
+	executionRetries     uint64
+	sponsorsRequired     uint64
+	reviewers            Reviewers
+	voterAllowlistActive bool
+	periodUnit           PeriodUnit
+	minLeadTime          uint64
+	maxLeadTime          uint64
+	maxActiveProposals   uint64
+	maxProposerProposals uint64
+	executors            Executors
 }
 
 func NewPolicy(
@@ -115,6 +405,20 @@ func NewPolicy(
 	whitelist Whitelist,
 	proposalReviewPeriod, registrationPeriod, preSnapshotPeriod, votingPeriod, postSnapshotPeriod, executionDelayPeriod uint64,
 	turnout, quorum PercentRatio,
+	depositRule DepositRule,
+	depositPeriod uint64,
+	depositTarget common.Big,
+	guardians Guardians,
+	objectionThreshold PercentRatio,
+	executionGracePeriod uint64,
+	executionRetries uint64,
+	sponsorsRequired uint64,
+	reviewers Reviewers,
+	voterAllowlistActive bool,
+	periodUnit PeriodUnit,
+	minLeadTime, maxLeadTime uint64,
+	maxActiveProposals, maxProposerProposals uint64,
+	executors Executors,
 ) Policy {
 	return Policy{
 		BaseHinter:           hint.NewBaseHinter(PolicyHint),
@@ -130,10 +434,41 @@ func NewPolicy(
 		executionDelayPeriod: executionDelayPeriod,
 		turnout:              turnout,
 		quorum:               quorum,
+		depositRule:          depositRule,
+		depositPeriod:        depositPeriod,
+		depositTarget:        depositTarget,
+		guardians:            guardians,
+		objectionThreshold:   objectionThreshold,
+		executionGracePeriod: executionGracePeriod,
+		executionRetries:     executionRetries,
+		sponsorsRequired:     sponsorsRequired,
+		reviewers:            reviewers,
+		voterAllowlistActive: voterAllowlistActive,
+		periodUnit:           periodUnit,
+		minLeadTime:          minLeadTime,
+		maxLeadTime:          maxLeadTime,
+		maxActiveProposals:   maxActiveProposals,
+		maxProposerProposals: maxProposerProposals,
+		executors:            executors,
 	}
 }
 
+// Bytes omits the policy fields added later when none of them is set, so the facts and the states
+// written before them keep their hashes.
 func (po Policy) Bytes() []byte {
+	if po.IsLegacy() {
+		return po.legacyBytes()
+	}
+
+	return util.ConcatBytesSlice(po.legacyBytes(), po.extendedBytes())
+}
+
+// IsLegacy reports whether none of the policy fields added later is set.
+func (po Policy) IsLegacy() bool {
+	return bytes.Equal(po.extendedBytes(), legacyPolicyExtendedBytes)
+}
+
+func (po Policy) legacyBytes() []byte {
 	return util.ConcatBytesSlice(
 		po.votingPowerToken.Bytes(),
 		po.threshold.Bytes(),
@@ -150,6 +485,38 @@ func (po Policy) Bytes() []byte {
 	)
 }
 
+func (po Policy) extendedBytes() []byte {
+	return util.ConcatBytesSlice(
+		po.depositRule.Bytes(),
+		util.Uint64ToBytes(po.depositPeriod),
+		po.depositTarget.Bytes(),
+		po.guardians.Bytes(),
+		po.objectionThreshold.Bytes(),
+		util.Uint64ToBytes(po.executionGracePeriod),
+		util.Uint64ToBytes(po.executionRetries),
+		util.Uint64ToBytes(po.sponsorsRequired),
+		po.reviewers.Bytes(),
+		util.BoolToBytes(po.voterAllowlistActive),
+		po.periodUnit.Bytes(),
+		util.Uint64ToBytes(po.minLeadTime),
+		util.Uint64ToBytes(po.maxLeadTime),
+		util.Uint64ToBytes(po.maxActiveProposals),
+		util.Uint64ToBytes(po.maxProposerProposals),
+		po.executors.Bytes(),
+	)
+}
+
+// legacyPolicyExtendedBytes are the bytes of the policy fields added later as they are decoded
+// from a policy written before them.
+var legacyPolicyExtendedBytes = Policy{
+	depositRule:   NewDepositRule(DepositKeep, DepositKeep, DepositKeep, DepositKeep),
+	depositTarget: common.ZeroBig,
+	guardians:     NewGuardians(nil, 0, common.ZeroBig),
+	reviewers:     NewReviewers(nil),
+	periodUnit:    PeriodUnitSecond,
+	executors:     NewExecutors(nil),
+}.extendedBytes()
+
 func (po Policy) IsValid([]byte) error {
 	e := util.StringError("invalid dao policy")
 
@@ -161,10 +528,32 @@ func (po Policy) IsValid([]byte) error {
 		po.proposerWhitelist,
 		po.turnout,
 		po.quorum,
+		po.depositRule,
+		po.depositTarget,
+		po.guardians,
+		po.reviewers,
+		po.periodUnit,
+		po.executors,
 	); err != nil {
 		return e.Wrap(err)
 	}
 
+	if 0 < po.depositPeriod && !po.depositTarget.OverZero() {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("deposit target must be bigger than zero with deposit period, got %v", po.depositTarget)))
+	}
+
+	if po.objectionThreshold != 0 {
+		if err := po.objectionThreshold.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	if 0 < po.maxLeadTime && po.maxLeadTime < po.minLeadTime {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("min lead time must not be bigger than max lead time, %d > %d", po.minLeadTime, po.maxLeadTime)))
+	}
+
 	return nil
 }
 
@@ -215,3 +604,88 @@ func (po Policy) Turnout() PercentRatio {
 func (po Policy) Quorum() PercentRatio {
 	return po.quorum
 }
+
+func (po Policy) DepositRule() DepositRule {
+	return po.depositRule
+}
+
+func (po Policy) DepositPeriod() uint64 {
+	return po.depositPeriod
+}
+
+func (po Policy) DepositTarget() common.Big {
+	return po.depositTarget
+}
+
+func (po Policy) Guardians() Guardians {
+	return po.guardians
+}
+
+// ObjectionThreshold is the ratio of the total supply which disapproval votes must exceed
+// to reject an optimistic proposal. Zero disables optimistic proposals.
+func (po Policy) ObjectionThreshold() PercentRatio {
+	return po.objectionThreshold
+}
+
+func (po Policy) ExecutionGracePeriod() uint64 {
+	return po.executionGracePeriod
+}
+
+func (po Policy) ExecutionRetries() uint64 {
+	return po.executionRetries
+}
+
+func (po Policy) SponsorsRequired() uint64 {
+	return po.sponsorsRequired
+}
+
+func (po Policy) Reviewers() Reviewers {
+	return po.reviewers
+}
+
+func (po Policy) VoterAllowlistActive() bool {
+	return po.voterAllowlistActive
+}
+
+// PeriodUnit is the unit of the periods and of the proposal start time; seconds compared
+// with the proposed time of the block, or block heights compared with the processing height.
+func (po Policy) PeriodUnit() PeriodUnit {
+	return po.periodUnit
+}
+
+// PeriodNow returns the current point of the proposal lifecycle in the period unit.
+func (po Policy) PeriodNow(proposedAt time.Time, height base.Height) uint64 {
+	if po.periodUnit == PeriodUnitHeight {
+		return uint64(height)
+	}
+
+	return uint64(proposedAt.Unix())
+}
+
+// MinLeadTime is the minimum time in the period unit from the submission of a proposal to its start time.
+func (po Policy) MinLeadTime() uint64 {
+	return po.minLeadTime
+}
+
+// MaxLeadTime is the maximum time in the period unit from the submission of a proposal to its start
+// time. Zero means no maximum.
+func (po Policy) MaxLeadTime() uint64 {
+	return po.maxLeadTime
+}
+
+// MaxActiveProposals is the maximum number of proposals of the dao which have not ended yet.
+// A proposal which has missed the period to move on is not counted. Zero means no maximum.
+func (po Policy) MaxActiveProposals() uint64 {
+	return po.maxActiveProposals
+}
+
+// MaxProposerProposals is the maximum number of proposals of a proposer which have not ended yet.
+// Zero means no maximum.
+func (po Policy) MaxProposerProposals() uint64 {
+	return po.maxProposerProposals
+}
+
+// Executors is the account set which alone can run the pre-snap, post-snap and execute operations.
+func (po Policy) Executors() Executors {
+	return po.executors
+}
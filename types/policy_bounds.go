@@ -0,0 +1,400 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var PolicyBoundsHint = hint.MustNewHint("mitum-dao-policy-bounds-v0.0.1")
+
+// PolicyBounds are the guard rails of the dao policy, fixed when the dao is registered.
+// Every policy the dao takes, by registration, policy update or governance execution, must
+// be within the bounds. A zero bound is not checked. The min proposal fee is checked in the currency
+// of the bounds; the bounds without the currency, registered before it, check only the amount.
+type PolicyBounds struct {
+	hint.BaseHinter
+	minProposalReviewPeriod uint64
+	maxProposalReviewPeriod uint64
+	minRegistrationPeriod   uint64
+	maxRegistrationPeriod   uint64
+	minPreSnapshotPeriod    uint64
+	maxPreSnapshotPeriod    uint64
+	minVotingPeriod         uint64
+	maxVotingPeriod         uint64
+	minPostSnapshotPeriod   uint64
+	maxPostSnapshotPeriod   uint64
+	minExecutionDelayPeriod uint64
+	maxExecutionDelayPeriod uint64
+	minTurnout              PercentRatio
+	maxTurnout              PercentRatio
+	minQuorum               PercentRatio
+	maxQuorum               PercentRatio
+	minThreshold            common.Big
+	maxThreshold            common.Big
+	minProposalFee          common.Big
+	proposalFeeCurrency     ctypes.CurrencyID
+	minObjectionThreshold   PercentRatio
+	maxObjectionThreshold   PercentRatio
+	minExecutionGracePeriod uint64
+	maxExecutionGracePeriod uint64
+	minGuardians            uint64
+	maxGuardians            uint64
+	minExecutors            uint64
+	maxExecutors            uint64
+}
+
+func NewPolicyBounds(
+	minProposalReviewPeriod, maxProposalReviewPeriod,
+	minRegistrationPeriod, maxRegistrationPeriod,
+	minPreSnapshotPeriod, maxPreSnapshotPeriod,
+	minVotingPeriod, maxVotingPeriod,
+	minPostSnapshotPeriod, maxPostSnapshotPeriod,
+	minExecutionDelayPeriod, maxExecutionDelayPeriod uint64,
+	minTurnout, maxTurnout, minQuorum, maxQuorum PercentRatio,
+	minThreshold, maxThreshold common.Big,
+	minProposalFee common.Big, proposalFeeCurrency ctypes.CurrencyID,
+	minObjectionThreshold, maxObjectionThreshold PercentRatio,
+	minExecutionGracePeriod, maxExecutionGracePeriod uint64,
+	minGuardians, maxGuardians, minExecutors, maxExecutors uint64,
+) PolicyBounds {
+	return PolicyBounds{
+		BaseHinter:              hint.NewBaseHinter(PolicyBoundsHint),
+		minProposalReviewPeriod: minProposalReviewPeriod,
+		maxProposalReviewPeriod: maxProposalReviewPeriod,
+		minRegistrationPeriod:   minRegistrationPeriod,
+		maxRegistrationPeriod:   maxRegistrationPeriod,
+		minPreSnapshotPeriod:    minPreSnapshotPeriod,
+		maxPreSnapshotPeriod:    maxPreSnapshotPeriod,
+		minVotingPeriod:         minVotingPeriod,
+		maxVotingPeriod:         maxVotingPeriod,
+		minPostSnapshotPeriod:   minPostSnapshotPeriod,
+		maxPostSnapshotPeriod:   maxPostSnapshotPeriod,
+		minExecutionDelayPeriod: minExecutionDelayPeriod,
+		maxExecutionDelayPeriod: maxExecutionDelayPeriod,
+		minTurnout:              minTurnout,
+		maxTurnout:              maxTurnout,
+		minQuorum:               minQuorum,
+		maxQuorum:               maxQuorum,
+		minThreshold:            minThreshold,
+		maxThreshold:            maxThreshold,
+		minProposalFee:          minProposalFee,
+		proposalFeeCurrency:     proposalFeeCurrency,
+		minObjectionThreshold:   minObjectionThreshold,
+		maxObjectionThreshold:   maxObjectionThreshold,
+		minExecutionGracePeriod: minExecutionGracePeriod,
+		maxExecutionGracePeriod: maxExecutionGracePeriod,
+		minGuardians:            minGuardians,
+		maxGuardians:            maxGuardians,
+		minExecutors:            minExecutors,
+		maxExecutors:            maxExecutors,
+	}
+}
+
+// NewUnboundedPolicyBounds returns the bounds which accept any policy.
+func NewUnboundedPolicyBounds() PolicyBounds {
+	return NewPolicyBounds(
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0,
+		common.ZeroBig, common.ZeroBig,
+		common.ZeroBig, "",
+		0, 0,
+		0, 0,
+		0, 0, 0, 0,
+	)
+}
+
+// IsUnbounded reports whether the bounds accept any policy, like the bounds of a dao registered
+// before them.
+func (pb PolicyBounds) IsUnbounded() bool {
+	return bytes.Equal(pb.Bytes(), NewUnboundedPolicyBounds().Bytes())
+}
+
+// Bytes leaves out the bounds added later when they are not set, so the bounds keep their bytes
+// from before them.
+func (pb PolicyBounds) Bytes() []byte {
+	if !pb.isExtended() {
+		return pb.legacyBytes()
+	}
+
+	return util.ConcatBytesSlice(
+		pb.legacyBytes(),
+		pb.proposalFeeCurrency.Bytes(),
+		pb.minObjectionThreshold.Bytes(),
+		pb.maxObjectionThreshold.Bytes(),
+		util.Uint64ToBytes(pb.minExecutionGracePeriod),
+		util.Uint64ToBytes(pb.maxExecutionGracePeriod),
+		util.Uint64ToBytes(pb.minGuardians),
+		util.Uint64ToBytes(pb.maxGuardians),
+		util.Uint64ToBytes(pb.minExecutors),
+		util.Uint64ToBytes(pb.maxExecutors),
+	)
+}
+
+func (pb PolicyBounds) isExtended() bool {
+	return len(pb.proposalFeeCurrency) > 0 ||
+		pb.minObjectionThreshold != 0 || pb.maxObjectionThreshold != 0 ||
+		pb.minExecutionGracePeriod != 0 || pb.maxExecutionGracePeriod != 0 ||
+		pb.minGuardians != 0 || pb.maxGuardians != 0 ||
+		pb.minExecutors != 0 || pb.maxExecutors != 0
+}
+
+func (pb PolicyBounds) legacyBytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(pb.minProposalReviewPeriod),
+		util.Uint64ToBytes(pb.maxProposalReviewPeriod),
+		util.Uint64ToBytes(pb.minRegistrationPeriod),
+		util.Uint64ToBytes(pb.maxRegistrationPeriod),
+		util.Uint64ToBytes(pb.minPreSnapshotPeriod),
+		util.Uint64ToBytes(pb.maxPreSnapshotPeriod),
+		util.Uint64ToBytes(pb.minVotingPeriod),
+		util.Uint64ToBytes(pb.maxVotingPeriod),
+		util.Uint64ToBytes(pb.minPostSnapshotPeriod),
+		util.Uint64ToBytes(pb.maxPostSnapshotPeriod),
+		util.Uint64ToBytes(pb.minExecutionDelayPeriod),
+		util.Uint64ToBytes(pb.maxExecutionDelayPeriod),
+		pb.minTurnout.Bytes(),
+		pb.maxTurnout.Bytes(),
+		pb.minQuorum.Bytes(),
+		pb.maxQuorum.Bytes(),
+		pb.minThreshold.Bytes(),
+		pb.maxThreshold.Bytes(),
+		pb.minProposalFee.Bytes(),
+	)
+}
+
+type uint64Bound struct {
+	name     string
+	min, max uint64
+}
+
+func (pb PolicyBounds) uint64Bounds() []uint64Bound {
+	return []uint64Bound{
+		{"proposal review period", pb.minProposalReviewPeriod, pb.maxProposalReviewPeriod},
+		{"registration period", pb.minRegistrationPeriod, pb.maxRegistrationPeriod},
+		{"pre snapshot period", pb.minPreSnapshotPeriod, pb.maxPreSnapshotPeriod},
+		{"voting period", pb.minVotingPeriod, pb.maxVotingPeriod},
+		{"post snapshot period", pb.minPostSnapshotPeriod, pb.maxPostSnapshotPeriod},
+		{"execution delay period", pb.minExecutionDelayPeriod, pb.maxExecutionDelayPeriod},
+		{"turnout", uint64(pb.minTurnout), uint64(pb.maxTurnout)},
+		{"quorum", uint64(pb.minQuorum), uint64(pb.maxQuorum)},
+		{"objection threshold", uint64(pb.minObjectionThreshold), uint64(pb.maxObjectionThreshold)},
+		{"execution grace period", pb.minExecutionGracePeriod, pb.maxExecutionGracePeriod},
+		{"guardians", pb.minGuardians, pb.maxGuardians},
+		{"executors", pb.minExecutors, pb.maxExecutors},
+	}
+}
+
+func (pb PolicyBounds) IsValid([]byte) error {
+	e := util.StringError("invalid policy bounds")
+
+	if err := util.CheckIsValiders(nil, false, pb.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, r := range []PercentRatio{
+		pb.minTurnout, pb.maxTurnout, pb.minQuorum, pb.maxQuorum, pb.minObjectionThreshold, pb.maxObjectionThreshold,
+	} {
+		if r == 0 {
+			continue
+		}
+
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	for _, b := range pb.uint64Bounds() {
+		if 0 < b.max && b.max < b.min {
+			return e.Wrap(common.ErrValOOR.Wrap(
+				errors.Errorf("min %s must not be bigger than max, %d > %d", b.name, b.min, b.max)))
+		}
+	}
+
+	names := []string{"min threshold", "max threshold", "min proposal fee"}
+	for i, b := range []common.Big{pb.minThreshold, pb.maxThreshold, pb.minProposalFee} {
+		if !b.OverNil() {
+			return e.Wrap(common.ErrValOOR.Wrap(
+				errors.Errorf("%s must be bigger than or equal to zero, got %v", names[i], b)))
+		}
+	}
+
+	if pb.maxThreshold.OverZero() && pb.maxThreshold.Compare(pb.minThreshold) < 0 {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("min threshold must not be bigger than max, %v > %v", pb.minThreshold, pb.maxThreshold)))
+	}
+
+	if len(pb.proposalFeeCurrency) > 0 {
+		if err := pb.proposalFeeCurrency.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// Check returns an error wrapping common.ErrValOOR if the policy is out of the bounds.
+func (pb PolicyBounds) Check(po Policy) error {
+	values := []uint64{
+		po.proposalReviewPeriod,
+		po.registrationPeriod,
+		po.preSnapshotPeriod,
+		po.votingPeriod,
+		po.postSnapshotPeriod,
+		po.executionDelayPeriod,
+		uint64(po.turnout),
+		uint64(po.quorum),
+		uint64(po.objectionThreshold),
+		po.executionGracePeriod,
+		uint64(len(po.guardians.accounts)),
+		uint64(len(po.executors.accounts)),
+	}
+
+	for i, b := range pb.uint64Bounds() {
+		if values[i] < b.min {
+			return common.ErrValOOR.Wrap(errors.Errorf("%s under min bound, %d < %d", b.name, values[i], b.min))
+		}
+
+		if 0 < b.max && b.max < values[i] {
+			return common.ErrValOOR.Wrap(errors.Errorf("%s over max bound, %d > %d", b.name, values[i], b.max))
+		}
+	}
+
+	if po.threshold.Compare(pb.minThreshold) < 0 {
+		return common.ErrValOOR.Wrap(errors.Errorf("threshold under min bound, %v < %v", po.threshold, pb.minThreshold))
+	}
+
+	if pb.maxThreshold.OverZero() && pb.maxThreshold.Compare(po.threshold) < 0 {
+		return common.ErrValOOR.Wrap(errors.Errorf("threshold over max bound, %v > %v", po.threshold, pb.maxThreshold))
+	}
+
+	if len(pb.proposalFeeCurrency) > 0 && pb.minProposalFee.OverZero() &&
+		po.proposalFee.Currency() != pb.proposalFeeCurrency {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("proposal fee currency is not the one of min bound, %q != %q",
+				po.proposalFee.Currency(), pb.proposalFeeCurrency))
+	}
+
+	if po.proposalFee.Big().Compare(pb.minProposalFee) < 0 {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("proposal fee under min bound, %v < %v", po.proposalFee.Big(), pb.minProposalFee))
+	}
+
+	return nil
+}
+
+func (pb PolicyBounds) MinProposalReviewPeriod() uint64 {
+	return pb.minProposalReviewPeriod
+}
+
+func (pb PolicyBounds) MaxProposalReviewPeriod() uint64 {
+	return pb.maxProposalReviewPeriod
+}
+
+func (pb PolicyBounds) MinRegistrationPeriod() uint64 {
+	return pb.minRegistrationPeriod
+}
+
+func (pb PolicyBounds) MaxRegistrationPeriod() uint64 {
+	return pb.maxRegistrationPeriod
+}
+
+func (pb PolicyBounds) MinPreSnapshotPeriod() uint64 {
+	return pb.minPreSnapshotPeriod
+}
+
+func (pb PolicyBounds) MaxPreSnapshotPeriod() uint64 {
+	return pb.maxPreSnapshotPeriod
+}
+
+func (pb PolicyBounds) MinVotingPeriod() uint64 {
+	return pb.minVotingPeriod
+}
+
+func (pb PolicyBounds) MaxVotingPeriod() uint64 {
+	return pb.maxVotingPeriod
+}
+
+func (pb PolicyBounds) MinPostSnapshotPeriod() uint64 {
+	return pb.minPostSnapshotPeriod
+}
+
+func (pb PolicyBounds) MaxPostSnapshotPeriod() uint64 {
+	return pb.maxPostSnapshotPeriod
+}
+
+func (pb PolicyBounds) MinExecutionDelayPeriod() uint64 {
+	return pb.minExecutionDelayPeriod
+}
+
+func (pb PolicyBounds) MaxExecutionDelayPeriod() uint64 {
+	return pb.maxExecutionDelayPeriod
+}
+
+func (pb PolicyBounds) MinTurnout() PercentRatio {
+	return pb.minTurnout
+}
+
+func (pb PolicyBounds) MaxTurnout() PercentRatio {
+	return pb.maxTurnout
+}
+
+func (pb PolicyBounds) MinQuorum() PercentRatio {
+	return pb.minQuorum
+}
+
+func (pb PolicyBounds) MaxQuorum() PercentRatio {
+	return pb.maxQuorum
+}
+
+func (pb PolicyBounds) MinThreshold() common.Big {
+	return pb.minThreshold
+}
+
+func (pb PolicyBounds) MaxThreshold() common.Big {
+	return pb.maxThreshold
+}
+
+func (pb PolicyBounds) MinProposalFee() common.Big {
+	return pb.minProposalFee
+}
+
+func (pb PolicyBounds) ProposalFeeCurrency() ctypes.CurrencyID {
+	return pb.proposalFeeCurrency
+}
+
+func (pb PolicyBounds) MinObjectionThreshold() PercentRatio {
+	return pb.minObjectionThreshold
+}
+
+func (pb PolicyBounds) MaxObjectionThreshold() PercentRatio {
+	return pb.maxObjectionThreshold
+}
+
+func (pb PolicyBounds) MinExecutionGracePeriod() uint64 {
+	return pb.minExecutionGracePeriod
+}
+
+func (pb PolicyBounds) MaxExecutionGracePeriod() uint64 {
+	return pb.maxExecutionGracePeriod
+}
+
+func (pb PolicyBounds) MinGuardians() uint64 {
+	return pb.minGuardians
+}
+
+func (pb PolicyBounds) MaxGuardians() uint64 {
+	return pb.maxGuardians
+}
+
+func (pb PolicyBounds) MinExecutors() uint64 {
+	return pb.minExecutors
+}
+
+func (pb PolicyBounds) MaxExecutors() uint64 {
+	return pb.maxExecutors
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (pb PolicyBounds) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":                      pb.Hint().String(),
+			"min_proposal_review_period": pb.minProposalReviewPeriod,
+			"max_proposal_review_period": pb.maxProposalReviewPeriod,
+			"min_registration_period":    pb.minRegistrationPeriod,
+			"max_registration_period":    pb.maxRegistrationPeriod,
+			"min_pre_snapshot_period":    pb.minPreSnapshotPeriod,
+			"max_pre_snapshot_period":    pb.maxPreSnapshotPeriod,
+			"min_voting_period":          pb.minVotingPeriod,
+			"max_voting_period":          pb.maxVotingPeriod,
+			"min_post_snapshot_period":   pb.minPostSnapshotPeriod,
+			"max_post_snapshot_period":   pb.maxPostSnapshotPeriod,
+			"min_execution_delay_period": pb.minExecutionDelayPeriod,
+			"max_execution_delay_period": pb.maxExecutionDelayPeriod,
+			"min_turnout":                pb.minTurnout,
+			"max_turnout":                pb.maxTurnout,
+			"min_quorum":                 pb.minQuorum,
+			"max_quorum":                 pb.maxQuorum,
+			"min_threshold":              pb.minThreshold,
+			"max_threshold":              pb.maxThreshold,
+			"min_proposal_fee":           pb.minProposalFee,
+			"proposal_fee_currency":      pb.proposalFeeCurrency,
+			"min_objection_threshold":    pb.minObjectionThreshold,
+			"max_objection_threshold":    pb.maxObjectionThreshold,
+			"min_execution_grace_period": pb.minExecutionGracePeriod,
+			"max_execution_grace_period": pb.maxExecutionGracePeriod,
+			"min_guardians":              pb.minGuardians,
+			"max_guardians":              pb.maxGuardians,
+			"min_executors":              pb.minExecutors,
+			"max_executors":              pb.maxExecutors,
+		},
+	)
+}
+
+type PolicyBoundsBSONUnmarshaler struct {
+	Hint                    string `bson:"_hint"`
+	MinProposalReviewPeriod uint64 `bson:"min_proposal_review_period"`
+	MaxProposalReviewPeriod uint64 `bson:"max_proposal_review_period"`
+	MinRegistrationPeriod   uint64 `bson:"min_registration_period"`
+	MaxRegistrationPeriod   uint64 `bson:"max_registration_period"`
+	MinPreSnapshotPeriod    uint64 `bson:"min_pre_snapshot_period"`
+	MaxPreSnapshotPeriod    uint64 `bson:"max_pre_snapshot_period"`
+	MinVotingPeriod         uint64 `bson:"min_voting_period"`
+	MaxVotingPeriod         uint64 `bson:"max_voting_period"`
+	MinPostSnapshotPeriod   uint64 `bson:"min_post_snapshot_period"`
+	MaxPostSnapshotPeriod   uint64 `bson:"max_post_snapshot_period"`
+	MinExecutionDelayPeriod uint64 `bson:"min_execution_delay_period"`
+	MaxExecutionDelayPeriod uint64 `bson:"max_execution_delay_period"`
+	MinTurnout              uint   `bson:"min_turnout"`
+	MaxTurnout              uint   `bson:"max_turnout"`
+	MinQuorum               uint   `bson:"min_quorum"`
+	MaxQuorum               uint   `bson:"max_quorum"`
+	MinThreshold            string `bson:"min_threshold"`
+	MaxThreshold            string `bson:"max_threshold"`
+	MinProposalFee          string `bson:"min_proposal_fee"`
+	ProposalFeeCurrency     string `bson:"proposal_fee_currency"`
+	MinObjectionThreshold   uint   `bson:"min_objection_threshold"`
+	MaxObjectionThreshold   uint   `bson:"max_objection_threshold"`
+	MinExecutionGracePeriod uint64 `bson:"min_execution_grace_period"`
+	MaxExecutionGracePeriod uint64 `bson:"max_execution_grace_period"`
+	MinGuardians            uint64 `bson:"min_guardians"`
+	MaxGuardians            uint64 `bson:"max_guardians"`
+	MinExecutors            uint64 `bson:"min_executors"`
+	MaxExecutors            uint64 `bson:"max_executors"`
+}
+
+func (pb *PolicyBounds) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of PolicyBounds")
+
+	var u PolicyBoundsBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return pb.unpack(ht,
+		u.MinProposalReviewPeriod, u.MaxProposalReviewPeriod,
+		u.MinRegistrationPeriod, u.MaxRegistrationPeriod,
+		u.MinPreSnapshotPeriod, u.MaxPreSnapshotPeriod,
+		u.MinVotingPeriod, u.MaxVotingPeriod,
+		u.MinPostSnapshotPeriod, u.MaxPostSnapshotPeriod,
+		u.MinExecutionDelayPeriod, u.MaxExecutionDelayPeriod,
+		u.MinTurnout, u.MaxTurnout, u.MinQuorum, u.MaxQuorum,
+		u.MinThreshold, u.MaxThreshold,
+		u.MinProposalFee, u.ProposalFeeCurrency,
+		u.MinObjectionThreshold, u.MaxObjectionThreshold,
+		u.MinExecutionGracePeriod, u.MaxExecutionGracePeriod,
+		u.MinGuardians, u.MaxGuardians, u.MinExecutors, u.MaxExecutors,
+	)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (pb *PolicyBounds) unpack(ht hint.Hint,
+	minrvp, maxrvp, minrgp, maxrgp, minprsp, maxprsp, minvp, maxvp, minpsp, maxpsp, minedp, maxedp uint64,
+	minto, maxto, minqou, maxqou uint,
+	minth, maxth string,
+	minpf, pfcid string,
+	minot, maxot uint,
+	minegp, maxegp, mingd, maxgd, minex, maxex uint64,
+) error {
+	e := util.StringError("failed to unmarshal PolicyBounds")
+
+	pb.BaseHinter = hint.NewBaseHinter(ht)
+	pb.minProposalReviewPeriod = minrvp
+	pb.maxProposalReviewPeriod = maxrvp
+	pb.minRegistrationPeriod = minrgp
+	pb.maxRegistrationPeriod = maxrgp
+	pb.minPreSnapshotPeriod = minprsp
+	pb.maxPreSnapshotPeriod = maxprsp
+	pb.minVotingPeriod = minvp
+	pb.maxVotingPeriod = maxvp
+	pb.minPostSnapshotPeriod = minpsp
+	pb.maxPostSnapshotPeriod = maxpsp
+	pb.minExecutionDelayPeriod = minedp
+	pb.maxExecutionDelayPeriod = maxedp
+	pb.minTurnout = PercentRatio(minto)
+	pb.maxTurnout = PercentRatio(maxto)
+	pb.minQuorum = PercentRatio(minqou)
+	pb.maxQuorum = PercentRatio(maxqou)
+	// the bounds encoded before the objection threshold, execution grace period, guardians and
+	// executors bounds and the proposal fee currency decode them as unset.
+	pb.proposalFeeCurrency = ctypes.CurrencyID(pfcid)
+	pb.minObjectionThreshold = PercentRatio(minot)
+	pb.maxObjectionThreshold = PercentRatio(maxot)
+	pb.minExecutionGracePeriod = minegp
+	pb.maxExecutionGracePeriod = maxegp
+	pb.minGuardians = mingd
+	pb.maxGuardians = maxgd
+	pb.minExecutors = minex
+	pb.maxExecutors = maxex
+
+	bigs := []*common.Big{&pb.minThreshold, &pb.maxThreshold, &pb.minProposalFee}
+	for i, s := range []string{minth, maxth, minpf} {
+		big, err := common.NewBigFromString(s)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		*bigs[i] = big
+	}
+
+	return nil
+}
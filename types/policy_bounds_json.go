@@ -0,0 +1,130 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type PolicyBoundsJSONMarshaler struct {
+	hint.BaseHinter
+	MinProposalReviewPeriod uint64       `json:"min_proposal_review_period"`
+	MaxProposalReviewPeriod uint64       `json:"max_proposal_review_period"`
+	MinRegistrationPeriod   uint64       `json:"min_registration_period"`
+	MaxRegistrationPeriod   uint64       `json:"max_registration_period"`
+	MinPreSnapshotPeriod    uint64       `json:"min_pre_snapshot_period"`
+	MaxPreSnapshotPeriod    uint64       `json:"max_pre_snapshot_period"`
+	MinVotingPeriod         uint64       `json:"min_voting_period"`
+	MaxVotingPeriod         uint64       `json:"max_voting_period"`
+	MinPostSnapshotPeriod   uint64       `json:"min_post_snapshot_period"`
+	MaxPostSnapshotPeriod   uint64       `json:"max_post_snapshot_period"`
+	MinExecutionDelayPeriod uint64       `json:"min_execution_delay_period"`
+	MaxExecutionDelayPeriod uint64       `json:"max_execution_delay_period"`
+	MinTurnout              PercentRatio `json:"min_turnout"`
+	MaxTurnout              PercentRatio `json:"max_turnout"`
+	MinQuorum               PercentRatio `json:"min_quorum"`
+	MaxQuorum               PercentRatio `json:"max_quorum"`
+	MinThreshold            common.Big   `json:"min_threshold"`
+	MaxThreshold            common.Big   `json:"max_threshold"`
+	MinProposalFee          common.Big   `json:"min_proposal_fee"`
+	ProposalFeeCurrency     string       `json:"proposal_fee_currency"`
+	MinObjectionThreshold   PercentRatio `json:"min_objection_threshold"`
+	MaxObjectionThreshold   PercentRatio `json:"max_objection_threshold"`
+	MinExecutionGracePeriod uint64       `json:"min_execution_grace_period"`
+	MaxExecutionGracePeriod uint64       `json:"max_execution_grace_period"`
+	MinGuardians            uint64       `json:"min_guardians"`
+	MaxGuardians            uint64       `json:"max_guardians"`
+	MinExecutors            uint64       `json:"min_executors"`
+	MaxExecutors            uint64       `json:"max_executors"`
+}
+
+func (pb PolicyBounds) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PolicyBoundsJSONMarshaler{
+		BaseHinter:              pb.BaseHinter,
+		MinProposalReviewPeriod: pb.minProposalReviewPeriod,
+		MaxProposalReviewPeriod: pb.maxProposalReviewPeriod,
+		MinRegistrationPeriod:   pb.minRegistrationPeriod,
+		MaxRegistrationPeriod:   pb.maxRegistrationPeriod,
+		MinPreSnapshotPeriod:    pb.minPreSnapshotPeriod,
+		MaxPreSnapshotPeriod:    pb.maxPreSnapshotPeriod,
+		MinVotingPeriod:         pb.minVotingPeriod,
+		MaxVotingPeriod:         pb.maxVotingPeriod,
+		MinPostSnapshotPeriod:   pb.minPostSnapshotPeriod,
+		MaxPostSnapshotPeriod:   pb.maxPostSnapshotPeriod,
+		MinExecutionDelayPeriod: pb.minExecutionDelayPeriod,
+		MaxExecutionDelayPeriod: pb.maxExecutionDelayPeriod,
+		MinTurnout:              pb.minTurnout,
+		MaxTurnout:              pb.maxTurnout,
+		MinQuorum:               pb.minQuorum,
+		MaxQuorum:               pb.maxQuorum,
+		MinThreshold:            pb.minThreshold,
+		MaxThreshold:            pb.maxThreshold,
+		MinProposalFee:          pb.minProposalFee,
+		ProposalFeeCurrency:     pb.proposalFeeCurrency.String(),
+		MinObjectionThreshold:   pb.minObjectionThreshold,
+		MaxObjectionThreshold:   pb.maxObjectionThreshold,
+		MinExecutionGracePeriod: pb.minExecutionGracePeriod,
+		MaxExecutionGracePeriod: pb.maxExecutionGracePeriod,
+		MinGuardians:            pb.minGuardians,
+		MaxGuardians:            pb.maxGuardians,
+		MinExecutors:            pb.minExecutors,
+		MaxExecutors:            pb.maxExecutors,
+	})
+}
+
+type PolicyBoundsJSONUnmarshaler struct {
+	Hint                    hint.Hint `json:"_hint"`
+	MinProposalReviewPeriod uint64    `json:"min_proposal_review_period"`
+	MaxProposalReviewPeriod uint64    `json:"max_proposal_review_period"`
+	MinRegistrationPeriod   uint64    `json:"min_registration_period"`
+	MaxRegistrationPeriod   uint64    `json:"max_registration_period"`
+	MinPreSnapshotPeriod    uint64    `json:"min_pre_snapshot_period"`
+	MaxPreSnapshotPeriod    uint64    `json:"max_pre_snapshot_period"`
+	MinVotingPeriod         uint64    `json:"min_voting_period"`
+	MaxVotingPeriod         uint64    `json:"max_voting_period"`
+	MinPostSnapshotPeriod   uint64    `json:"min_post_snapshot_period"`
+	MaxPostSnapshotPeriod   uint64    `json:"max_post_snapshot_period"`
+	MinExecutionDelayPeriod uint64    `json:"min_execution_delay_period"`
+	MaxExecutionDelayPeriod uint64    `json:"max_execution_delay_period"`
+	MinTurnout              uint      `json:"min_turnout"`
+	MaxTurnout              uint      `json:"max_turnout"`
+	MinQuorum               uint      `json:"min_quorum"`
+	MaxQuorum               uint      `json:"max_quorum"`
+	MinThreshold            string    `json:"min_threshold"`
+	MaxThreshold            string    `json:"max_threshold"`
+	MinProposalFee          string    `json:"min_proposal_fee"`
+	ProposalFeeCurrency     string    `json:"proposal_fee_currency"`
+	MinObjectionThreshold   uint      `json:"min_objection_threshold"`
+	MaxObjectionThreshold   uint      `json:"max_objection_threshold"`
+	MinExecutionGracePeriod uint64    `json:"min_execution_grace_period"`
+	MaxExecutionGracePeriod uint64    `json:"max_execution_grace_period"`
+	MinGuardians            uint64    `json:"min_guardians"`
+	MaxGuardians            uint64    `json:"max_guardians"`
+	MinExecutors            uint64    `json:"min_executors"`
+	MaxExecutors            uint64    `json:"max_executors"`
+}
+
+func (pb *PolicyBounds) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of PolicyBounds")
+
+	var u PolicyBoundsJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return pb.unpack(u.Hint,
+		u.MinProposalReviewPeriod, u.MaxProposalReviewPeriod,
+		u.MinRegistrationPeriod, u.MaxRegistrationPeriod,
+		u.MinPreSnapshotPeriod, u.MaxPreSnapshotPeriod,
+		u.MinVotingPeriod, u.MaxVotingPeriod,
+		u.MinPostSnapshotPeriod, u.MaxPostSnapshotPeriod,
+		u.MinExecutionDelayPeriod, u.MaxExecutionDelayPeriod,
+		u.MinTurnout, u.MaxTurnout, u.MinQuorum, u.MaxQuorum,
+		u.MinThreshold, u.MaxThreshold,
+		u.MinProposalFee, u.ProposalFeeCurrency,
+		u.MinObjectionThreshold, u.MaxObjectionThreshold,
+		u.MinExecutionGracePeriod, u.MaxExecutionGracePeriod,
+		u.MinGuardians, u.MaxGuardians, u.MinExecutors, u.MaxExecutors,
+	)
+}
@@ -39,6 +39,100 @@ func (wl *Whitelist) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	return wl.unpack(enc, ht, uw.Active, uw.Accounts)
 }
 
+func (gd Guardians) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":          gd.Hint().String(),
+			"accounts":       gd.accounts,
+			"threshold":      gd.threshold,
+			"transfer_limit": gd.transferLimit,
+		},
+	)
+}
+
+type GuardiansBSONUnmarshaler struct {
+	Hint          string   `bson:"_hint"`
+	Accounts      []string `bson:"accounts"`
+	Threshold     uint     `bson:"threshold"`
+	TransferLimit string   `bson:"transfer_limit"`
+}
+
+func (gd *Guardians) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Guardians")
+
+	var ug GuardiansBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ug); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ug.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return gd.unpack(enc, ht, ug.Accounts, ug.Threshold, ug.TransferLimit)
+}
+
+func (rv Reviewers) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    rv.Hint().String(),
+			"accounts": rv.accounts,
+		},
+	)
+}
+
+type ReviewersBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Accounts []string `bson:"accounts"`
+}
+
+func (rv *Reviewers) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Reviewers")
+
+	var ur ReviewersBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ur); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ur.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return rv.unpack(enc, ht, ur.Accounts)
+}
+
+func (ex Executors) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    ex.Hint().String(),
+			"accounts": ex.accounts,
+		},
+	)
+}
+
+type ExecutorsBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Accounts []string `bson:"accounts"`
+}
+
+func (ex *Executors) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Executors")
+
+	var ue ExecutorsBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ue); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ue.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return ex.unpack(enc, ht, ue.Accounts)
+}
+
 func (po Policy) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
@@ -55,6 +149,22 @@ func (po Policy) MarshalBSON() ([]byte, error) {
 			"execution_delay_period": po.executionDelayPeriod,
 			"turnout":                po.turnout,
 			"quorum":                 po.quorum,
+			"deposit_rule":           po.depositRule,
+			"deposit_period":         po.depositPeriod,
+			"deposit_target":         po.depositTarget,
+			"guardians":              po.guardians,
+			"objection_threshold":    po.objectionThreshold,
+			"execution_grace_period": po.executionGracePeriod,
+			"execution_retries":      po.executionRetries,
+			"sponsors_required":      po.sponsorsRequired,
+			"reviewers":              po.reviewers,
+			"voter_allowlist_active": po.voterAllowlistActive,
+			"period_unit":            po.periodUnit,
+			"min_lead_time":          po.minLeadTime,
+			"max_lead_time":          po.maxLeadTime,
+			"max_active_proposals":   po.maxActiveProposals,
+			"max_proposer_proposals": po.maxProposerProposals,
+			"executors":              po.executors,
 		},
 	)
 }
@@ -73,6 +183,22 @@ type PolicyBSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64   `bson:"execution_delay_period"`
 	Turnout              uint     `bson:"turnout"`
 	Quorum               uint     `bson:"quorum"`
+	DepositRule          bson.Raw `bson:"deposit_rule"`
+	DepositPeriod        uint64   `bson:"deposit_period"`
+	DepositTarget        string   `bson:"deposit_target"`
+	Guardians            bson.Raw `bson:"guardians"`
+	ObjectionThreshold   uint     `bson:"objection_threshold"`
+	ExecutionGracePeriod uint64   `bson:"execution_grace_period"`
+	ExecutionRetries     uint64   `bson:"execution_retries"`
+	SponsorsRequired     uint64   `bson:"sponsors_required"`
+	Reviewers            bson.Raw `bson:"reviewers"`
+	VoterAllowlistActive bool     `bson:"voter_allowlist_active"`
+	PeriodUnit           uint8    `bson:"period_unit"`
+	MinLeadTime          uint64   `bson:"min_lead_time"`
+	MaxLeadTime          uint64   `bson:"max_lead_time"`
+	MaxActiveProposals   uint64   `bson:"max_active_proposals"`
+	MaxProposerProposals uint64   `bson:"max_proposer_proposals"`
+	Executors            bson.Raw `bson:"executors"`
 }
 
 func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -101,5 +227,21 @@ func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		upo.ExecutionDelayPeriod,
 		upo.Turnout,
 		upo.Quorum,
+		upo.DepositRule,
+		upo.DepositPeriod,
+		upo.DepositTarget,
+		upo.Guardians,
+		upo.ObjectionThreshold,
+		upo.ExecutionGracePeriod,
+		upo.ExecutionRetries,
+		upo.SponsorsRequired,
+		upo.Reviewers,
+		upo.VoterAllowlistActive,
+		upo.PeriodUnit,
+		upo.MinLeadTime,
+		upo.MaxLeadTime,
+		upo.MaxActiveProposals,
+		upo.MaxProposerProposals,
+		upo.Executors,
 	)
 }
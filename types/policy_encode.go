@@ -10,6 +10,34 @@ import (
 	"github.com/pkg/errors"
 )
 
+// DecodeHinterOr decodes the hinter of b and returns def when b is empty, so the
+// fields added later decode from the states and facts already stored.
+func DecodeHinterOr[T any](enc encoder.Encoder, b []byte, def T) (T, error) {
+	hinter, err := enc.Decode(b)
+	switch {
+	case err != nil:
+		return def, err
+	case hinter == nil:
+		return def, nil
+	}
+
+	v, ok := hinter.(T)
+	if !ok {
+		return def, errors.Errorf("expected %T, not %T", def, hinter)
+	}
+
+	return v, nil
+}
+
+// DecodeBigOr is DecodeHinterOr for the big number fields.
+func DecodeBigOr(s string, def common.Big) (common.Big, error) {
+	if len(s) < 1 {
+		return def, nil
+	}
+
+	return common.NewBigFromString(s)
+}
+
 func (wl *Whitelist) unpack(enc encoder.Encoder, ht hint.Hint, at bool, acs []string) error {
 	e := util.StringError("failed to unmarshal Whitelist")
 
@@ -31,11 +59,89 @@ func (wl *Whitelist) unpack(enc encoder.Encoder, ht hint.Hint, at bool, acs []st
 	return nil
 }
 
+func (gd *Guardians) unpack(enc encoder.Encoder, ht hint.Hint, acs []string, th uint, tl string) error {
+	e := util.StringError("failed to unmarshal Guardians")
+
+	gd.BaseHinter = hint.NewBaseHinter(ht)
+	gd.threshold = th
+
+	accs := make([]base.Address, len(acs))
+	for i, ac := range acs {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			accs[i] = a
+		}
+	}
+	gd.accounts = accs
+
+	if big, err := common.NewBigFromString(tl); err != nil {
+		return e.Wrap(err)
+	} else {
+		gd.transferLimit = big
+	}
+
+	return nil
+}
+
+func (rv *Reviewers) unpack(enc encoder.Encoder, ht hint.Hint, acs []string) error {
+	e := util.StringError("failed to unmarshal Reviewers")
+
+	rv.BaseHinter = hint.NewBaseHinter(ht)
+
+	accs := make([]base.Address, len(acs))
+	for i, ac := range acs {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			accs[i] = a
+		}
+	}
+	rv.accounts = accs
+
+	return nil
+}
+
+func (ex *Executors) unpack(enc encoder.Encoder, ht hint.Hint, acs []string) error {
+	e := util.StringError("failed to unmarshal Executors")
+
+	ex.BaseHinter = hint.NewBaseHinter(ht)
+
+	accs := make([]base.Address, len(acs))
+	for i, ac := range acs {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			accs[i] = a
+		}
+	}
+	ex.accounts = accs
+
+	return nil
+}
+
 func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint,
 	cr, th string,
 	bf, bw []byte,
 	rvp, rgp, prsp, vp, psp, edp uint64,
 	to, qou uint,
+	bdr []byte,
+	dp uint64,
+	dt string,
+	bgd []byte,
+	ot uint,
+	egp uint64,
+	er uint64,
+	sr uint64,
+	brv []byte,
+	va bool,
+	pu uint8,
+	mnlt, mxlt uint64,
+	mxap, mxpp uint64,
+	bex []byte,
 ) error {
 	e := util.StringError("failed to unmarshal Policy")
 
@@ -72,5 +178,52 @@ func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint,
 		po.proposerWhitelist = wl
 	}
 
+	if dr, err := DecodeHinterOr(enc, bdr, NewDepositRule(DepositKeep, DepositKeep, DepositKeep, DepositKeep)); err != nil {
+		return e.Wrap(err)
+	} else {
+		po.depositRule = dr
+	}
+
+	po.depositPeriod = dp
+
+	if big, err := DecodeBigOr(dt, common.ZeroBig); err != nil {
+		return e.Wrap(err)
+	} else {
+		po.depositTarget = big
+	}
+
+	if gd, err := DecodeHinterOr(enc, bgd, NewGuardians(nil, 0, common.ZeroBig)); err != nil {
+		return e.Wrap(err)
+	} else {
+		po.guardians = gd
+	}
+
+	po.objectionThreshold = PercentRatio(ot)
+
+	po.executionGracePeriod = egp
+
+	po.executionRetries = er
+
+	po.sponsorsRequired = sr
+
+	if rv, err := DecodeHinterOr(enc, brv, NewReviewers(nil)); err != nil {
+		return e.Wrap(err)
+	} else {
+		po.reviewers = rv
+	}
+
+	po.voterAllowlistActive = va
+	po.periodUnit = PeriodUnit(pu)
+	po.minLeadTime = mnlt
+	po.maxLeadTime = mxlt
+	po.maxActiveProposals = mxap
+	po.maxProposerProposals = mxpp
+
+	if ex, err := DecodeHinterOr(enc, bex, NewExecutors(nil)); err != nil {
+		return e.Wrap(err)
+	} else {
+		po.executors = ex
+	}
+
 	return nil
 }
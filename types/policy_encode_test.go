@@ -0,0 +1,285 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	jsonenc "github.com/imfact-labs/mitum2/util/encoder/json"
+)
+
+func newTestJSONEncoder(t *testing.T) *jsonenc.Encoder {
+	enc := jsonenc.NewEncoder()
+
+	for _, d := range []encoder.DecodeDetail{
+		{Hint: ctypes.AmountHint, Instance: ctypes.Amount{}},
+		{Hint: WhitelistHint, Instance: Whitelist{}},
+		{Hint: DepositRuleHint, Instance: DepositRule{}},
+		{Hint: GuardiansHint, Instance: Guardians{}},
+		{Hint: ReviewersHint, Instance: Reviewers{}},
+		{Hint: ExecutorsHint, Instance: Executors{}},
+		{Hint: PolicyBoundsHint, Instance: PolicyBounds{}},
+		{Hint: PolicyHint, Instance: Policy{}},
+		{Hint: PolicyPatchHint, Instance: PolicyPatch{}},
+		{Hint: DesignHint, Instance: Design{}},
+		{Hint: GovernanceCalldataHint, Instance: GovernanceCallData{}},
+	} {
+		if err := enc.Add(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return enc
+}
+
+// legacyJSON marshals v and drops the keys, like a value written before the keys were added.
+func legacyJSON(t *testing.T, v interface{}, keys ...string) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range keys {
+		delete(m, k)
+	}
+
+	b, err = json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func newTestPolicy() Policy {
+	return NewPolicy(
+		ctypes.CurrencyID("MCC"),
+		common.NewBig(10),
+		ctypes.NewAmount(common.NewBig(3), ctypes.CurrencyID("MCC")),
+		NewWhitelist(false, nil),
+		10, 10, 10, 10, 10, 10,
+		PercentRatio(30), PercentRatio(50),
+		NewDepositRule(DepositRefund, DepositBurn, DepositRefund, DepositRefund),
+		10,
+		common.NewBig(3),
+		NewGuardians(nil, 0, common.ZeroBig),
+		PercentRatio(0),
+		10, 1, 0,
+		NewReviewers(nil),
+		false,
+		PeriodUnitSecond,
+		0, 0, 0, 0,
+		NewExecutors(nil),
+	)
+}
+
+var legacyPolicyKeys = []string{
+	"deposit_rule", "deposit_period", "deposit_target", "guardians", "objection_threshold",
+	"execution_grace_period", "execution_retries", "sponsors_required", "reviewers",
+	"voter_allowlist_active", "period_unit", "min_lead_time", "max_lead_time",
+	"max_active_proposals", "max_proposer_proposals", "executors",
+}
+
+func TestPolicyDecodeLegacyJSON(t *testing.T) {
+	enc := newTestJSONEncoder(t)
+
+	hinter, err := enc.Decode(legacyJSON(t, newTestPolicy(), legacyPolicyKeys...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	po, ok := hinter.(Policy)
+	if !ok {
+		t.Fatalf("expected Policy, not %T", hinter)
+	}
+
+	if err := po.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if dr := po.DepositRule(); dr.Action(Completed) != DepositKeep || dr.Action(Vetoed) != DepositKeep ||
+		dr.Action(Canceled) != DepositKeep || dr.OnWithdrawn() != DepositKeep {
+		t.Fatalf("expected keep-all deposit rule, got %v", dr)
+	}
+
+	if po.Guardians().Active() || po.Reviewers().Active() || po.Executors().Active() {
+		t.Fatal("expected inactive guardians, reviewers and executors")
+	}
+
+	if po.DepositPeriod() != 0 || !po.DepositTarget().Equal(common.ZeroBig) ||
+		po.MaxActiveProposals() != 0 || po.MaxProposerProposals() != 0 {
+		t.Fatal("expected zero deposit and caps")
+	}
+
+	if po.PeriodUnit() != PeriodUnitSecond {
+		t.Fatalf("expected seconds period unit, got %v", po.PeriodUnit())
+	}
+}
+
+func TestDesignDecodeLegacyJSON(t *testing.T) {
+	enc := newTestJSONEncoder(t)
+
+	de := NewDesign(DAOOption("crypto"), newTestPolicy(), NewUnboundedPolicyBounds())
+
+	hinter, err := enc.Decode(legacyJSON(t, de, "bounds"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if de, ok := hinter.(Design); !ok {
+		t.Fatalf("expected Design, not %T", hinter)
+	} else if err := de.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGovernanceCallDataDecodeLegacyJSON(t *testing.T) {
+	enc := newTestJSONEncoder(t)
+
+	po := newTestPolicy()
+
+	b, err := json.Marshal(map[string]interface{}{
+		"_hint":  GovernanceCalldataHint.String(),
+		"policy": po,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cd, ok := hinter.(GovernanceCallData)
+	if !ok {
+		t.Fatalf("expected GovernanceCallData, not %T", hinter)
+	}
+
+	if err := cd.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	current := NewPolicy(
+		ctypes.CurrencyID("MCC"),
+		common.NewBig(20),
+		ctypes.NewAmount(common.NewBig(3), ctypes.CurrencyID("MCC")),
+		NewWhitelist(false, nil),
+		20, 20, 20, 20, 20, 20,
+		PercentRatio(10), PercentRatio(10),
+		NewDepositRule(DepositBurn, DepositBurn, DepositBurn, DepositBurn),
+		0,
+		common.ZeroBig,
+		NewGuardians(nil, 0, common.ZeroBig),
+		PercentRatio(0),
+		0, 0, 0,
+		NewReviewers(nil),
+		false,
+		PeriodUnitSecond,
+		0, 0, 0, 0,
+		NewExecutors(nil),
+	)
+
+	applied := cd.Patch().Apply(current)
+	if !applied.Threshold().Equal(po.Threshold()) || applied.VotingPeriod() != po.VotingPeriod() {
+		t.Fatal("expected the legacy policy fields to be replaced")
+	}
+
+	if applied.DepositRule().OnCompleted() != DepositBurn {
+		t.Fatal("expected the fields added later to be kept")
+	}
+}
+
+func TestPolicyBoundsDecodeLegacyJSON(t *testing.T) {
+	enc := newTestJSONEncoder(t)
+
+	pb := NewPolicyBounds(
+		1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20,
+		PercentRatio(10), PercentRatio(90), PercentRatio(10), PercentRatio(90),
+		common.NewBig(1), common.NewBig(100),
+		common.NewBig(1), "",
+		0, 0,
+		0, 0,
+		0, 0, 0, 0,
+	)
+
+	hinter, err := enc.Decode(legacyJSON(t, pb,
+		"proposal_fee_currency", "min_objection_threshold", "max_objection_threshold",
+		"min_execution_grace_period", "max_execution_grace_period",
+		"min_guardians", "max_guardians", "min_executors", "max_executors",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, ok := hinter.(PolicyBounds)
+	if !ok {
+		t.Fatalf("expected PolicyBounds, not %T", hinter)
+	}
+
+	if err := decoded.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(decoded.Bytes(), pb.legacyBytes()) {
+		t.Fatal("expected the bytes of the legacy bounds")
+	}
+}
+
+func TestPolicyBoundsCheck(t *testing.T) {
+	bounds := func(
+		proposalFeeCurrency ctypes.CurrencyID, maxObjectionThreshold PercentRatio,
+		minExecutionGracePeriod, maxGuardians, maxExecutors uint64,
+	) PolicyBounds {
+		return NewPolicyBounds(
+			0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0,
+			common.ZeroBig, common.ZeroBig,
+			common.NewBig(1), proposalFeeCurrency,
+			0, maxObjectionThreshold,
+			minExecutionGracePeriod, 0,
+			0, maxGuardians, 0, maxExecutors,
+		)
+	}
+
+	po := newTestPolicy()
+
+	if err := bounds("MCC", 0, 0, 0, 0).Check(po); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, pb := range map[string]PolicyBounds{
+		"proposal fee currency":  bounds("ABC", 0, 0, 0, 0),
+		"execution grace period": bounds("", 0, 11, 0, 0),
+	} {
+		if err := pb.Check(po); err == nil {
+			t.Fatalf("expected the policy out of the %s bound", name)
+		}
+	}
+
+	a := ctypes.NewAddress("a")
+	b := ctypes.NewAddress("b")
+
+	po.objectionThreshold = PercentRatio(20)
+	po.guardians = NewGuardians([]base.Address{a, b}, 1, common.ZeroBig)
+	po.executors = NewExecutors([]base.Address{a, b})
+
+	for name, pb := range map[string]PolicyBounds{
+		"objection threshold": bounds("", 10, 0, 0, 0),
+		"guardians":           bounds("", 0, 0, 1, 0),
+		"executors":           bounds("", 0, 0, 0, 1),
+	} {
+		if err := pb.Check(po); err == nil {
+			t.Fatalf("expected the policy out of the %s bound", name)
+		}
+	}
+}
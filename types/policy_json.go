@@ -42,6 +42,96 @@ func (wl *Whitelist) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	return wl.unpack(enc, uw.Hint, uw.Active, uw.Accounts)
 }
 
+type GuardiansJSONMarshaler struct {
+	hint.BaseHinter
+	Accounts      []base.Address `json:"accounts"`
+	Threshold     uint           `json:"threshold"`
+	TransferLimit common.Big     `json:"transfer_limit"`
+}
+
+func (gd Guardians) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GuardiansJSONMarshaler{
+		BaseHinter:    gd.BaseHinter,
+		Accounts:      gd.accounts,
+		Threshold:     gd.threshold,
+		TransferLimit: gd.transferLimit,
+	})
+}
+
+type GuardiansJSONUnmarshaler struct {
+	Hint          hint.Hint `json:"_hint"`
+	Accounts      []string  `json:"accounts"`
+	Threshold     uint      `json:"threshold"`
+	TransferLimit string    `json:"transfer_limit"`
+}
+
+func (gd *Guardians) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Guardians")
+
+	var ug GuardiansJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ug); err != nil {
+		return e.Wrap(err)
+	}
+
+	return gd.unpack(enc, ug.Hint, ug.Accounts, ug.Threshold, ug.TransferLimit)
+}
+
+type ReviewersJSONMarshaler struct {
+	hint.BaseHinter
+	Accounts []base.Address `json:"accounts"`
+}
+
+func (rv Reviewers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReviewersJSONMarshaler{
+		BaseHinter: rv.BaseHinter,
+		Accounts:   rv.accounts,
+	})
+}
+
+type ReviewersJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Accounts []string  `json:"accounts"`
+}
+
+func (rv *Reviewers) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Reviewers")
+
+	var ur ReviewersJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ur); err != nil {
+		return e.Wrap(err)
+	}
+
+	return rv.unpack(enc, ur.Hint, ur.Accounts)
+}
+
+type ExecutorsJSONMarshaler struct {
+	hint.BaseHinter
+	Accounts []base.Address `json:"accounts"`
+}
+
+func (ex Executors) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExecutorsJSONMarshaler{
+		BaseHinter: ex.BaseHinter,
+		Accounts:   ex.accounts,
+	})
+}
+
+type ExecutorsJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Accounts []string  `json:"accounts"`
+}
+
+func (ex *Executors) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Executors")
+
+	var ue ExecutorsJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ue); err != nil {
+		return e.Wrap(err)
+	}
+
+	return ex.unpack(enc, ue.Hint, ue.Accounts)
+}
+
 type PolicyJSONMarshaler struct {
 	hint.BaseHinter
 	Token                ctypes.CurrencyID `json:"voting_power_token"`
@@ -56,6 +146,22 @@ type PolicyJSONMarshaler struct {
 	ExecutionDelayPeriod uint64            `json:"execution_delay_period"`
 	Turnout              PercentRatio      `json:"turnout"`
 	Quorum               PercentRatio      `json:"quorum"`
+	DepositRule          DepositRule       `json:"deposit_rule"`
+	DepositPeriod        uint64            `json:"deposit_period"`
+	DepositTarget        common.Big        `json:"deposit_target"`
+	Guardians            Guardians         `json:"guardians"`
+	ObjectionThreshold   PercentRatio      `json:"objection_threshold"`
+	ExecutionGracePeriod uint64            `json:"execution_grace_period"`
+	ExecutionRetries     uint64            `json:"execution_retries"`
+	SponsorsRequired     uint64            `json:"sponsors_required"`
+	Reviewers            Reviewers         `json:"reviewers"`
+	VoterAllowlistActive bool              `json:"voter_allowlist_active"`
+	PeriodUnit           PeriodUnit        `json:"period_unit"`
+	MinLeadTime          uint64            `json:"min_lead_time"`
+	MaxLeadTime          uint64            `json:"max_lead_time"`
+	MaxActiveProposals   uint64            `json:"max_active_proposals"`
+	MaxProposerProposals uint64            `json:"max_proposer_proposals"`
+	Executors            Executors         `json:"executors"`
 }
 
 func (po Policy) MarshalJSON() ([]byte, error) {
@@ -73,6 +179,22 @@ func (po Policy) MarshalJSON() ([]byte, error) {
 		ExecutionDelayPeriod: po.executionDelayPeriod,
 		Turnout:              po.turnout,
 		Quorum:               po.quorum,
+		DepositRule:          po.depositRule,
+		DepositPeriod:        po.depositPeriod,
+		DepositTarget:        po.depositTarget,
+		Guardians:            po.guardians,
+		ObjectionThreshold:   po.objectionThreshold,
+		ExecutionGracePeriod: po.executionGracePeriod,
+		ExecutionRetries:     po.executionRetries,
+		SponsorsRequired:     po.sponsorsRequired,
+		Reviewers:            po.reviewers,
+		VoterAllowlistActive: po.voterAllowlistActive,
+		PeriodUnit:           po.periodUnit,
+		MinLeadTime:          po.minLeadTime,
+		MaxLeadTime:          po.maxLeadTime,
+		MaxActiveProposals:   po.maxActiveProposals,
+		MaxProposerProposals: po.maxProposerProposals,
+		Executors:            po.executors,
 	})
 }
 
@@ -90,6 +212,22 @@ type PolicyJSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64          `json:"execution_delay_period"`
 	Turnout              uint            `json:"turnout"`
 	Quorum               uint            `json:"quorum"`
+	DepositRule          json.RawMessage `json:"deposit_rule"`
+	DepositPeriod        uint64          `json:"deposit_period"`
+	DepositTarget        string          `json:"deposit_target"`
+	Guardians            json.RawMessage `json:"guardians"`
+	ObjectionThreshold   uint            `json:"objection_threshold"`
+	ExecutionGracePeriod uint64          `json:"execution_grace_period"`
+	ExecutionRetries     uint64          `json:"execution_retries"`
+	SponsorsRequired     uint64          `json:"sponsors_required"`
+	Reviewers            json.RawMessage `json:"reviewers"`
+	VoterAllowlistActive bool            `json:"voter_allowlist_active"`
+	PeriodUnit           uint8           `json:"period_unit"`
+	MinLeadTime          uint64          `json:"min_lead_time"`
+	MaxLeadTime          uint64          `json:"max_lead_time"`
+	MaxActiveProposals   uint64          `json:"max_active_proposals"`
+	MaxProposerProposals uint64          `json:"max_proposer_proposals"`
+	Executors            json.RawMessage `json:"executors"`
 }
 
 func (po *Policy) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -113,5 +251,21 @@ func (po *Policy) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		upo.ExecutionDelayPeriod,
 		upo.Turnout,
 		upo.Quorum,
+		upo.DepositRule,
+		upo.DepositPeriod,
+		upo.DepositTarget,
+		upo.Guardians,
+		upo.ObjectionThreshold,
+		upo.ExecutionGracePeriod,
+		upo.ExecutionRetries,
+		upo.SponsorsRequired,
+		upo.Reviewers,
+		upo.VoterAllowlistActive,
+		upo.PeriodUnit,
+		upo.MinLeadTime,
+		upo.MaxLeadTime,
+		upo.MaxActiveProposals,
+		upo.MaxProposerProposals,
+		upo.Executors,
 	)
 }
@@ -0,0 +1,416 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var PolicyPatchHint = hint.MustNewHint("mitum-dao-policy-patch-v0.0.1")
+
+// PolicyPatch is a partial policy. A nil field keeps the value of the current policy, so a patch
+// applied on top of the current design at processing time does not revert concurrent changes
+// of the other fields.
+type PolicyPatch struct {
+	hint.BaseHinter
+	votingPowerToken     *ctypes.CurrencyID
+	threshold            *common.Big
+	proposalFee          *ctypes.Amount
+	proposerWhitelist    *Whitelist
+	proposalReviewPeriod *uint64
+	registrationPeriod   *uint64
+	preSnapshotPeriod    *uint64
+	votingPeriod         *uint64
+	postSnapshotPeriod   *uint64
+	executionDelayPeriod *uint64
+	turnout              *PercentRatio
+	quorum               *PercentRatio
+	depositRule          *DepositRule
+	depositPeriod        *uint64
+	depositTarget        *common.Big
+	guardians            *Guardians
+	objectionThreshold   *PercentRatio
+	executionGracePeriod *uint64
+	executionRetries     *uint64
+	sponsorsRequired     *uint64
+	reviewers            *Reviewers
+	voterAllowlistActive *bool
+	minLeadTime          *uint64
+	maxLeadTime          *uint64
+	maxActiveProposals   *uint64
+	maxProposerProposals *uint64
+	executors            *Executors
+}
+
+func NewPolicyPatch(
+	token *ctypes.CurrencyID,
+	threshold *common.Big,
+	fee *ctypes.Amount,
+	whitelist *Whitelist,
+	proposalReviewPeriod, registrationPeriod, preSnapshotPeriod, votingPeriod, postSnapshotPeriod, executionDelayPeriod *uint64,
+	turnout, quorum *PercentRatio,
+	depositRule *DepositRule,
+	depositPeriod *uint64,
+	depositTarget *common.Big,
+	guardians *Guardians,
+	objectionThreshold *PercentRatio,
+	executionGracePeriod *uint64,
+	executionRetries *uint64,
+	sponsorsRequired *uint64,
+	reviewers *Reviewers,
+	voterAllowlistActive *bool,
+	minLeadTime, maxLeadTime *uint64,
+	maxActiveProposals, maxProposerProposals *uint64,
+	executors *Executors,
+) PolicyPatch {
+	return PolicyPatch{
+		BaseHinter:           hint.NewBaseHinter(PolicyPatchHint),
+		votingPowerToken:     token,
+		threshold:            threshold,
+		proposalFee:          fee,
+		proposerWhitelist:    whitelist,
+		proposalReviewPeriod: proposalReviewPeriod,
+		registrationPeriod:   registrationPeriod,
+		preSnapshotPeriod:    preSnapshotPeriod,
+		votingPeriod:         votingPeriod,
+		postSnapshotPeriod:   postSnapshotPeriod,
+		executionDelayPeriod: executionDelayPeriod,
+		turnout:              turnout,
+		quorum:               quorum,
+		depositRule:          depositRule,
+		depositPeriod:        depositPeriod,
+		depositTarget:        depositTarget,
+		guardians:            guardians,
+		objectionThreshold:   objectionThreshold,
+		executionGracePeriod: executionGracePeriod,
+		executionRetries:     executionRetries,
+		sponsorsRequired:     sponsorsRequired,
+		reviewers:            reviewers,
+		voterAllowlistActive: voterAllowlistActive,
+		minLeadTime:          minLeadTime,
+		maxLeadTime:          maxLeadTime,
+		maxActiveProposals:   maxActiveProposals,
+		maxProposerProposals: maxProposerProposals,
+		executors:            executors,
+	}
+}
+
+// NewPolicyPatchFromPolicy returns the patch which replaces the policy fields a
+// governance proposal could change before policy patches, by the ones of po.
+func NewPolicyPatchFromPolicy(po Policy) PolicyPatch {
+	token, threshold, fee, whitelist := po.votingPowerToken, po.threshold, po.proposalFee, po.proposerWhitelist
+	rvp, rgp, prsp := po.proposalReviewPeriod, po.registrationPeriod, po.preSnapshotPeriod
+	vp, psp, edp := po.votingPeriod, po.postSnapshotPeriod, po.executionDelayPeriod
+	turnout, quorum := po.turnout, po.quorum
+
+	return NewPolicyPatch(
+		&token, &threshold, &fee, &whitelist,
+		&rvp, &rgp, &prsp, &vp, &psp, &edp,
+		&turnout, &quorum,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+	)
+}
+
+func patchBytes[T any](v *T, f func(T) []byte) []byte {
+	if v == nil {
+		return []byte{0}
+	}
+
+	return util.ConcatBytesSlice([]byte{1}, f(*v))
+}
+
+// Bytes of the patch which replaces the policy fields a governance proposal could change before
+// policy patches, and no other, are the bytes of those fields in the policy, so the facts written
+// with the whole policy keep their hashes.
+func (pp PolicyPatch) Bytes() []byte {
+	if pp.isLegacy() {
+		return util.ConcatBytesSlice(
+			pp.votingPowerToken.Bytes(),
+			pp.threshold.Bytes(),
+			pp.proposalFee.Bytes(),
+			pp.proposerWhitelist.Bytes(),
+			util.Uint64ToBytes(*pp.proposalReviewPeriod),
+			util.Uint64ToBytes(*pp.registrationPeriod),
+			util.Uint64ToBytes(*pp.preSnapshotPeriod),
+			util.Uint64ToBytes(*pp.votingPeriod),
+			util.Uint64ToBytes(*pp.postSnapshotPeriod),
+			util.Uint64ToBytes(*pp.executionDelayPeriod),
+			pp.turnout.Bytes(),
+			pp.quorum.Bytes(),
+		)
+	}
+
+	return util.ConcatBytesSlice(
+		patchBytes(pp.votingPowerToken, ctypes.CurrencyID.Bytes),
+		patchBytes(pp.threshold, common.Big.Bytes),
+		patchBytes(pp.proposalFee, ctypes.Amount.Bytes),
+		patchBytes(pp.proposerWhitelist, Whitelist.Bytes),
+		patchBytes(pp.proposalReviewPeriod, util.Uint64ToBytes),
+		patchBytes(pp.registrationPeriod, util.Uint64ToBytes),
+		patchBytes(pp.preSnapshotPeriod, util.Uint64ToBytes),
+		patchBytes(pp.votingPeriod, util.Uint64ToBytes),
+		patchBytes(pp.postSnapshotPeriod, util.Uint64ToBytes),
+		patchBytes(pp.executionDelayPeriod, util.Uint64ToBytes),
+		patchBytes(pp.turnout, PercentRatio.Bytes),
+		patchBytes(pp.quorum, PercentRatio.Bytes),
+		patchBytes(pp.depositRule, DepositRule.Bytes),
+		patchBytes(pp.depositPeriod, util.Uint64ToBytes),
+		patchBytes(pp.depositTarget, common.Big.Bytes),
+		patchBytes(pp.guardians, Guardians.Bytes),
+		patchBytes(pp.objectionThreshold, PercentRatio.Bytes),
+		patchBytes(pp.executionGracePeriod, util.Uint64ToBytes),
+		patchBytes(pp.executionRetries, util.Uint64ToBytes),
+		patchBytes(pp.sponsorsRequired, util.Uint64ToBytes),
+		patchBytes(pp.reviewers, Reviewers.Bytes),
+		patchBytes(pp.voterAllowlistActive, util.BoolToBytes),
+		patchBytes(pp.minLeadTime, util.Uint64ToBytes),
+		patchBytes(pp.maxLeadTime, util.Uint64ToBytes),
+		patchBytes(pp.maxActiveProposals, util.Uint64ToBytes),
+		patchBytes(pp.maxProposerProposals, util.Uint64ToBytes),
+		patchBytes(pp.executors, Executors.Bytes),
+	)
+}
+
+func (pp PolicyPatch) IsValid([]byte) error {
+	e := util.StringError("invalid dao policy patch")
+
+	vs := []util.IsValider{pp.BaseHinter}
+	if pp.votingPowerToken != nil {
+		vs = append(vs, *pp.votingPowerToken)
+	}
+	if pp.threshold != nil {
+		vs = append(vs, *pp.threshold)
+	}
+	if pp.proposalFee != nil {
+		vs = append(vs, *pp.proposalFee)
+	}
+	if pp.proposerWhitelist != nil {
+		vs = append(vs, *pp.proposerWhitelist)
+	}
+	if pp.turnout != nil {
+		vs = append(vs, *pp.turnout)
+	}
+	if pp.quorum != nil {
+		vs = append(vs, *pp.quorum)
+	}
+	if pp.depositRule != nil {
+		vs = append(vs, *pp.depositRule)
+	}
+	if pp.depositTarget != nil {
+		vs = append(vs, *pp.depositTarget)
+	}
+	if pp.guardians != nil {
+		vs = append(vs, *pp.guardians)
+	}
+	if pp.reviewers != nil {
+		vs = append(vs, *pp.reviewers)
+	}
+	if pp.executors != nil {
+		vs = append(vs, *pp.executors)
+	}
+
+	if err := util.CheckIsValiders(nil, false, vs...); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.proposalFee != nil && !pp.proposalFee.Big().OverNil() {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("fee amount must be bigger than or equal to zero, got %v", pp.proposalFee.Big())))
+	}
+
+	if pp.threshold != nil && !pp.threshold.OverZero() {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("threshold must be bigger than zero, got %v", *pp.threshold)))
+	}
+
+	for _, p := range []struct {
+		name   string
+		period *uint64
+	}{
+		{"registrationPeriod", pp.registrationPeriod},
+		{"preSnapshotPeriod", pp.preSnapshotPeriod},
+		{"votingPeriod", pp.votingPeriod},
+		{"postSnapshotPeriod", pp.postSnapshotPeriod},
+	} {
+		if p.period != nil && *p.period == 0 {
+			return e.Wrap(common.ErrValOOR.Wrap(errors.Errorf("%s must be bigger than zero", p.name)))
+		}
+	}
+
+	if pp.objectionThreshold != nil && *pp.objectionThreshold != 0 {
+		if err := pp.objectionThreshold.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// isLegacy reports whether the patch replaces the policy fields a governance proposal could change
+// before policy patches, like NewPolicyPatchFromPolicy, and no other.
+func (pp PolicyPatch) isLegacy() bool {
+	return pp.votingPowerToken != nil &&
+		pp.threshold != nil &&
+		pp.proposalFee != nil &&
+		pp.proposerWhitelist != nil &&
+		pp.proposalReviewPeriod != nil &&
+		pp.registrationPeriod != nil &&
+		pp.preSnapshotPeriod != nil &&
+		pp.votingPeriod != nil &&
+		pp.postSnapshotPeriod != nil &&
+		pp.executionDelayPeriod != nil &&
+		pp.turnout != nil &&
+		pp.quorum != nil &&
+		pp.depositRule == nil &&
+		pp.depositPeriod == nil &&
+		pp.depositTarget == nil &&
+		pp.guardians == nil &&
+		pp.objectionThreshold == nil &&
+		pp.executionGracePeriod == nil &&
+		pp.executionRetries == nil &&
+		pp.sponsorsRequired == nil &&
+		pp.reviewers == nil &&
+		pp.voterAllowlistActive == nil &&
+		pp.minLeadTime == nil &&
+		pp.maxLeadTime == nil &&
+		pp.maxActiveProposals == nil &&
+		pp.maxProposerProposals == nil &&
+		pp.executors == nil
+}
+
+// IsEmpty reports whether the patch changes no field.
+func (pp PolicyPatch) IsEmpty() bool {
+	return pp.votingPowerToken == nil &&
+		pp.threshold == nil &&
+		pp.proposalFee == nil &&
+		pp.proposerWhitelist == nil &&
+		pp.proposalReviewPeriod == nil &&
+		pp.registrationPeriod == nil &&
+		pp.preSnapshotPeriod == nil &&
+		pp.votingPeriod == nil &&
+		pp.postSnapshotPeriod == nil &&
+		pp.executionDelayPeriod == nil &&
+		pp.turnout == nil &&
+		pp.quorum == nil &&
+		pp.depositRule == nil &&
+		pp.depositPeriod == nil &&
+		pp.depositTarget == nil &&
+		pp.guardians == nil &&
+		pp.objectionThreshold == nil &&
+		pp.executionGracePeriod == nil &&
+		pp.executionRetries == nil &&
+		pp.sponsorsRequired == nil &&
+		pp.reviewers == nil &&
+		pp.voterAllowlistActive == nil &&
+		pp.minLeadTime == nil &&
+		pp.maxLeadTime == nil &&
+		pp.maxActiveProposals == nil &&
+		pp.maxProposerProposals == nil &&
+		pp.executors == nil
+}
+
+// Apply returns the policy with the fields of the patch replaced.
+func (pp PolicyPatch) Apply(po Policy) Policy {
+	if pp.votingPowerToken != nil {
+		po.votingPowerToken = *pp.votingPowerToken
+	}
+	if pp.threshold != nil {
+		po.threshold = *pp.threshold
+	}
+	if pp.proposalFee != nil {
+		po.proposalFee = *pp.proposalFee
+	}
+	if pp.proposerWhitelist != nil {
+		po.proposerWhitelist = *pp.proposerWhitelist
+	}
+	if pp.proposalReviewPeriod != nil {
+		po.proposalReviewPeriod = *pp.proposalReviewPeriod
+	}
+	if pp.registrationPeriod != nil {
+		po.registrationPeriod = *pp.registrationPeriod
+	}
+	if pp.preSnapshotPeriod != nil {
+		po.preSnapshotPeriod = *pp.preSnapshotPeriod
+	}
+	if pp.votingPeriod != nil {
+		po.votingPeriod = *pp.votingPeriod
+	}
+	if pp.postSnapshotPeriod != nil {
+		po.postSnapshotPeriod = *pp.postSnapshotPeriod
+	}
+	if pp.executionDelayPeriod != nil {
+		po.executionDelayPeriod = *pp.executionDelayPeriod
+	}
+	if pp.turnout != nil {
+		po.turnout = *pp.turnout
+	}
+	if pp.quorum != nil {
+		po.quorum = *pp.quorum
+	}
+	if pp.depositRule != nil {
+		po.depositRule = *pp.depositRule
+	}
+	if pp.depositPeriod != nil {
+		po.depositPeriod = *pp.depositPeriod
+	}
+	if pp.depositTarget != nil {
+		po.depositTarget = *pp.depositTarget
+	}
+	if pp.guardians != nil {
+		po.guardians = *pp.guardians
+	}
+	if pp.objectionThreshold != nil {
+		po.objectionThreshold = *pp.objectionThreshold
+	}
+	if pp.executionGracePeriod != nil {
+		po.executionGracePeriod = *pp.executionGracePeriod
+	}
+	if pp.executionRetries != nil {
+		po.executionRetries = *pp.executionRetries
+	}
+	if pp.sponsorsRequired != nil {
+		po.sponsorsRequired = *pp.sponsorsRequired
+	}
+	if pp.reviewers != nil {
+		po.reviewers = *pp.reviewers
+	}
+	if pp.voterAllowlistActive != nil {
+		po.voterAllowlistActive = *pp.voterAllowlistActive
+	}
+	if pp.minLeadTime != nil {
+		po.minLeadTime = *pp.minLeadTime
+	}
+	if pp.maxLeadTime != nil {
+		po.maxLeadTime = *pp.maxLeadTime
+	}
+	if pp.maxActiveProposals != nil {
+		po.maxActiveProposals = *pp.maxActiveProposals
+	}
+	if pp.maxProposerProposals != nil {
+		po.maxProposerProposals = *pp.maxProposerProposals
+	}
+	if pp.executors != nil {
+		po.executors = *pp.executors
+	}
+
+	return po
+}
+
+func (pp PolicyPatch) VotingPowerToken() *ctypes.CurrencyID {
+	return pp.votingPowerToken
+}
+
+func (pp PolicyPatch) ProposalFee() *ctypes.Amount {
+	return pp.proposalFee
+}
+
+func (pp PolicyPatch) Whitelist() *Whitelist {
+	return pp.proposerWhitelist
+}
+
+// Executors is the executor set of the patch; only a governance proposal can change it.
+func (pp PolicyPatch) Executors() *Executors {
+	return pp.executors
+}
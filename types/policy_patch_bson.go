@@ -0,0 +1,173 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (pp PolicyPatch) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint": pp.Hint().String(),
+	}
+
+	if pp.votingPowerToken != nil {
+		m["voting_power_token"] = *pp.votingPowerToken
+	}
+	if pp.threshold != nil {
+		m["threshold"] = *pp.threshold
+	}
+	if pp.proposalFee != nil {
+		m["proposal_fee"] = *pp.proposalFee
+	}
+	if pp.proposerWhitelist != nil {
+		m["proposer_whitelist"] = *pp.proposerWhitelist
+	}
+	if pp.proposalReviewPeriod != nil {
+		m["proposal_review_period"] = *pp.proposalReviewPeriod
+	}
+	if pp.registrationPeriod != nil {
+		m["registration_period"] = *pp.registrationPeriod
+	}
+	if pp.preSnapshotPeriod != nil {
+		m["pre_snapshot_period"] = *pp.preSnapshotPeriod
+	}
+	if pp.votingPeriod != nil {
+		m["voting_period"] = *pp.votingPeriod
+	}
+	if pp.postSnapshotPeriod != nil {
+		m["post_snapshot_period"] = *pp.postSnapshotPeriod
+	}
+	if pp.executionDelayPeriod != nil {
+		m["execution_delay_period"] = *pp.executionDelayPeriod
+	}
+	if pp.turnout != nil {
+		m["turnout"] = *pp.turnout
+	}
+	if pp.quorum != nil {
+		m["quorum"] = *pp.quorum
+	}
+	if pp.depositRule != nil {
+		m["deposit_rule"] = *pp.depositRule
+	}
+	if pp.depositPeriod != nil {
+		m["deposit_period"] = *pp.depositPeriod
+	}
+	if pp.depositTarget != nil {
+		m["deposit_target"] = *pp.depositTarget
+	}
+	if pp.guardians != nil {
+		m["guardians"] = *pp.guardians
+	}
+	if pp.objectionThreshold != nil {
+		m["objection_threshold"] = *pp.objectionThreshold
+	}
+	if pp.executionGracePeriod != nil {
+		m["execution_grace_period"] = *pp.executionGracePeriod
+	}
+	if pp.executionRetries != nil {
+		m["execution_retries"] = *pp.executionRetries
+	}
+	if pp.sponsorsRequired != nil {
+		m["sponsors_required"] = *pp.sponsorsRequired
+	}
+	if pp.reviewers != nil {
+		m["reviewers"] = *pp.reviewers
+	}
+	if pp.voterAllowlistActive != nil {
+		m["voter_allowlist_active"] = *pp.voterAllowlistActive
+	}
+	if pp.minLeadTime != nil {
+		m["min_lead_time"] = *pp.minLeadTime
+	}
+	if pp.maxLeadTime != nil {
+		m["max_lead_time"] = *pp.maxLeadTime
+	}
+	if pp.maxActiveProposals != nil {
+		m["max_active_proposals"] = *pp.maxActiveProposals
+	}
+	if pp.maxProposerProposals != nil {
+		m["max_proposer_proposals"] = *pp.maxProposerProposals
+	}
+	if pp.executors != nil {
+		m["executors"] = *pp.executors
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type PolicyPatchBSONUnmarshaler struct {
+	Hint                 string   `bson:"_hint"`
+	Token                *string  `bson:"voting_power_token"`
+	Threshold            *string  `bson:"threshold"`
+	Fee                  bson.Raw `bson:"proposal_fee"`
+	Whitelist            bson.Raw `bson:"proposer_whitelist"`
+	ProposalReviewPeriod *uint64  `bson:"proposal_review_period"`
+	RegistrationPeriod   *uint64  `bson:"registration_period"`
+	PreSnapshotPeriod    *uint64  `bson:"pre_snapshot_period"`
+	VotingPeriod         *uint64  `bson:"voting_period"`
+	PostSnapshotPeriod   *uint64  `bson:"post_snapshot_period"`
+	ExecutionDelayPeriod *uint64  `bson:"execution_delay_period"`
+	Turnout              *uint    `bson:"turnout"`
+	Quorum               *uint    `bson:"quorum"`
+	DepositRule          bson.Raw `bson:"deposit_rule"`
+	DepositPeriod        *uint64  `bson:"deposit_period"`
+	DepositTarget        *string  `bson:"deposit_target"`
+	Guardians            bson.Raw `bson:"guardians"`
+	ObjectionThreshold   *uint    `bson:"objection_threshold"`
+	ExecutionGracePeriod *uint64  `bson:"execution_grace_period"`
+	ExecutionRetries     *uint64  `bson:"execution_retries"`
+	SponsorsRequired     *uint64  `bson:"sponsors_required"`
+	Reviewers            bson.Raw `bson:"reviewers"`
+	VoterAllowlistActive *bool    `bson:"voter_allowlist_active"`
+	MinLeadTime          *uint64  `bson:"min_lead_time"`
+	MaxLeadTime          *uint64  `bson:"max_lead_time"`
+	MaxActiveProposals   *uint64  `bson:"max_active_proposals"`
+	MaxProposerProposals *uint64  `bson:"max_proposer_proposals"`
+	Executors            bson.Raw `bson:"executors"`
+}
+
+func (pp *PolicyPatch) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of PolicyPatch")
+
+	var upp PolicyPatchBSONUnmarshaler
+	if err := enc.Unmarshal(b, &upp); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(upp.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return pp.unpack(enc, ht,
+		upp.Token,
+		upp.Threshold,
+		upp.Fee,
+		upp.Whitelist,
+		upp.ProposalReviewPeriod,
+		upp.RegistrationPeriod,
+		upp.PreSnapshotPeriod,
+		upp.VotingPeriod,
+		upp.PostSnapshotPeriod,
+		upp.ExecutionDelayPeriod,
+		upp.Turnout,
+		upp.Quorum,
+		upp.DepositRule,
+		upp.DepositPeriod,
+		upp.DepositTarget,
+		upp.Guardians,
+		upp.ObjectionThreshold,
+		upp.ExecutionGracePeriod,
+		upp.ExecutionRetries,
+		upp.SponsorsRequired,
+		upp.Reviewers,
+		upp.VoterAllowlistActive,
+		upp.MinLeadTime,
+		upp.MaxLeadTime,
+		upp.MaxActiveProposals,
+		upp.MaxProposerProposals,
+		upp.Executors,
+	)
+}
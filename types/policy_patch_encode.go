@@ -0,0 +1,134 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func decodePatchHinter[T any](enc encoder.Encoder, b []byte) (*T, error) {
+	if len(b) < 1 {
+		return nil, nil
+	}
+
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := hinter.(T)
+	if !ok {
+		return nil, errors.Errorf("expected %T, not %T", *new(T), hinter)
+	}
+
+	return &v, nil
+}
+
+func decodePatchBig(s *string) (*common.Big, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	big, err := common.NewBigFromString(*s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &big, nil
+}
+
+func decodePatchRatio(r *uint) *PercentRatio {
+	if r == nil {
+		return nil
+	}
+
+	v := PercentRatio(*r)
+
+	return &v
+}
+
+func (pp *PolicyPatch) unpack(enc encoder.Encoder, ht hint.Hint,
+	cr, th *string,
+	bf, bw []byte,
+	rvp, rgp, prsp, vp, psp, edp *uint64,
+	to, qou *uint,
+	bdr []byte,
+	dp *uint64,
+	dt *string,
+	bgd []byte,
+	ot *uint,
+	egp *uint64,
+	er *uint64,
+	sr *uint64,
+	brv []byte,
+	va *bool,
+	mnlt, mxlt *uint64,
+	mxap, mxpp *uint64,
+	bex []byte,
+) error {
+	e := util.StringError("failed to unmarshal PolicyPatch")
+
+	pp.BaseHinter = hint.NewBaseHinter(ht)
+
+	if cr != nil {
+		cid := ctypes.CurrencyID(*cr)
+		pp.votingPowerToken = &cid
+	}
+
+	pp.proposalReviewPeriod = rvp
+	pp.registrationPeriod = rgp
+	pp.preSnapshotPeriod = prsp
+	pp.votingPeriod = vp
+	pp.postSnapshotPeriod = psp
+	pp.executionDelayPeriod = edp
+	pp.turnout = decodePatchRatio(to)
+	pp.quorum = decodePatchRatio(qou)
+	pp.depositPeriod = dp
+	pp.objectionThreshold = decodePatchRatio(ot)
+	pp.executionGracePeriod = egp
+	pp.executionRetries = er
+	pp.sponsorsRequired = sr
+	pp.voterAllowlistActive = va
+	pp.minLeadTime = mnlt
+	pp.maxLeadTime = mxlt
+	pp.maxActiveProposals = mxap
+	pp.maxProposerProposals = mxpp
+
+	var err error
+	if pp.threshold, err = decodePatchBig(th); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.depositTarget, err = decodePatchBig(dt); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.proposalFee, err = decodePatchHinter[ctypes.Amount](enc, bf); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.proposerWhitelist, err = decodePatchHinter[Whitelist](enc, bw); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.depositRule, err = decodePatchHinter[DepositRule](enc, bdr); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.guardians, err = decodePatchHinter[Guardians](enc, bgd); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.reviewers, err = decodePatchHinter[Reviewers](enc, brv); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pp.executors, err = decodePatchHinter[Executors](enc, bex); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
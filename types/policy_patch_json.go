@@ -0,0 +1,145 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type PolicyPatchJSONMarshaler struct {
+	hint.BaseHinter
+	Token                *ctypes.CurrencyID `json:"voting_power_token,omitempty"`
+	Threshold            *common.Big        `json:"threshold,omitempty"`
+	Fee                  *ctypes.Amount     `json:"proposal_fee,omitempty"`
+	Whitelist            *Whitelist         `json:"proposer_whitelist,omitempty"`
+	ProposalReviewPeriod *uint64            `json:"proposal_review_period,omitempty"`
+	RegistrationPeriod   *uint64            `json:"registration_period,omitempty"`
+	PreSnapshotPeriod    *uint64            `json:"pre_snapshot_period,omitempty"`
+	VotingPeriod         *uint64            `json:"voting_period,omitempty"`
+	PostSnapshotPeriod   *uint64            `json:"post_snapshot_period,omitempty"`
+	ExecutionDelayPeriod *uint64            `json:"execution_delay_period,omitempty"`
+	Turnout              *PercentRatio      `json:"turnout,omitempty"`
+	Quorum               *PercentRatio      `json:"quorum,omitempty"`
+	DepositRule          *DepositRule       `json:"deposit_rule,omitempty"`
+	DepositPeriod        *uint64            `json:"deposit_period,omitempty"`
+	DepositTarget        *common.Big        `json:"deposit_target,omitempty"`
+	Guardians            *Guardians         `json:"guardians,omitempty"`
+	ObjectionThreshold   *PercentRatio      `json:"objection_threshold,omitempty"`
+	ExecutionGracePeriod *uint64            `json:"execution_grace_period,omitempty"`
+	ExecutionRetries     *uint64            `json:"execution_retries,omitempty"`
+	SponsorsRequired     *uint64            `json:"sponsors_required,omitempty"`
+	Reviewers            *Reviewers         `json:"reviewers,omitempty"`
+	VoterAllowlistActive *bool              `json:"voter_allowlist_active,omitempty"`
+	MinLeadTime          *uint64            `json:"min_lead_time,omitempty"`
+	MaxLeadTime          *uint64            `json:"max_lead_time,omitempty"`
+	MaxActiveProposals   *uint64            `json:"max_active_proposals,omitempty"`
+	MaxProposerProposals *uint64            `json:"max_proposer_proposals,omitempty"`
+	Executors            *Executors         `json:"executors,omitempty"`
+}
+
+func (pp PolicyPatch) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PolicyPatchJSONMarshaler{
+		BaseHinter:           pp.BaseHinter,
+		Token:                pp.votingPowerToken,
+		Threshold:            pp.threshold,
+		Fee:                  pp.proposalFee,
+		Whitelist:            pp.proposerWhitelist,
+		ProposalReviewPeriod: pp.proposalReviewPeriod,
+		RegistrationPeriod:   pp.registrationPeriod,
+		PreSnapshotPeriod:    pp.preSnapshotPeriod,
+		VotingPeriod:         pp.votingPeriod,
+		PostSnapshotPeriod:   pp.postSnapshotPeriod,
+		ExecutionDelayPeriod: pp.executionDelayPeriod,
+		Turnout:              pp.turnout,
+		Quorum:               pp.quorum,
+		DepositRule:          pp.depositRule,
+		DepositPeriod:        pp.depositPeriod,
+		DepositTarget:        pp.depositTarget,
+		Guardians:            pp.guardians,
+		ObjectionThreshold:   pp.objectionThreshold,
+		ExecutionGracePeriod: pp.executionGracePeriod,
+		ExecutionRetries:     pp.executionRetries,
+		SponsorsRequired:     pp.sponsorsRequired,
+		Reviewers:            pp.reviewers,
+		VoterAllowlistActive: pp.voterAllowlistActive,
+		MinLeadTime:          pp.minLeadTime,
+		MaxLeadTime:          pp.maxLeadTime,
+		MaxActiveProposals:   pp.maxActiveProposals,
+		MaxProposerProposals: pp.maxProposerProposals,
+		Executors:            pp.executors,
+	})
+}
+
+type PolicyPatchJSONUnmarshaler struct {
+	Hint                 hint.Hint       `json:"_hint"`
+	Token                *string         `json:"voting_power_token"`
+	Threshold            *string         `json:"threshold"`
+	Fee                  json.RawMessage `json:"proposal_fee"`
+	Whitelist            json.RawMessage `json:"proposer_whitelist"`
+	ProposalReviewPeriod *uint64         `json:"proposal_review_period"`
+	RegistrationPeriod   *uint64         `json:"registration_period"`
+	PreSnapshotPeriod    *uint64         `json:"pre_snapshot_period"`
+	VotingPeriod         *uint64         `json:"voting_period"`
+	PostSnapshotPeriod   *uint64         `json:"post_snapshot_period"`
+	ExecutionDelayPeriod *uint64         `json:"execution_delay_period"`
+	Turnout              *uint           `json:"turnout"`
+	Quorum               *uint           `json:"quorum"`
+	DepositRule          json.RawMessage `json:"deposit_rule"`
+	DepositPeriod        *uint64         `json:"deposit_period"`
+	DepositTarget        *string         `json:"deposit_target"`
+	Guardians            json.RawMessage `json:"guardians"`
+	ObjectionThreshold   *uint           `json:"objection_threshold"`
+	ExecutionGracePeriod *uint64         `json:"execution_grace_period"`
+	ExecutionRetries     *uint64         `json:"execution_retries"`
+	SponsorsRequired     *uint64         `json:"sponsors_required"`
+	Reviewers            json.RawMessage `json:"reviewers"`
+	VoterAllowlistActive *bool           `json:"voter_allowlist_active"`
+	MinLeadTime          *uint64         `json:"min_lead_time"`
+	MaxLeadTime          *uint64         `json:"max_lead_time"`
+	MaxActiveProposals   *uint64         `json:"max_active_proposals"`
+	MaxProposerProposals *uint64         `json:"max_proposer_proposals"`
+	Executors            json.RawMessage `json:"executors"`
+}
+
+func (pp *PolicyPatch) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of PolicyPatch")
+
+	var upp PolicyPatchJSONUnmarshaler
+	if err := enc.Unmarshal(b, &upp); err != nil {
+		return e.Wrap(err)
+	}
+
+	return pp.unpack(enc, upp.Hint,
+		upp.Token,
+		upp.Threshold,
+		upp.Fee,
+		upp.Whitelist,
+		upp.ProposalReviewPeriod,
+		upp.RegistrationPeriod,
+		upp.PreSnapshotPeriod,
+		upp.VotingPeriod,
+		upp.PostSnapshotPeriod,
+		upp.ExecutionDelayPeriod,
+		upp.Turnout,
+		upp.Quorum,
+		upp.DepositRule,
+		upp.DepositPeriod,
+		upp.DepositTarget,
+		upp.Guardians,
+		upp.ObjectionThreshold,
+		upp.ExecutionGracePeriod,
+		upp.ExecutionRetries,
+		upp.SponsorsRequired,
+		upp.Reviewers,
+		upp.VoterAllowlistActive,
+		upp.MinLeadTime,
+		upp.MaxLeadTime,
+		upp.MaxActiveProposals,
+		upp.MaxProposerProposals,
+		upp.Executors,
+	)
+}
@@ -37,6 +37,9 @@ const (
 	ProposalBiz    = DAOOption("biz")
 )
 
+// MaxProposalDependencies is the maximum number of prerequisite proposals of a proposal.
+const MaxProposalDependencies = 10
+
 var (
 	CryptoProposalHint = hint.MustNewHint("mitum-dao-crypto-proposal-v0.0.1")
 	BizProposalHint    = hint.MustNewHint("mitum-dao-biz-proposal-v0.0.1")
@@ -51,21 +54,67 @@ type Proposal interface {
 	Proposer() base.Address
 	StartTime() uint64
 	Addresses() []base.Address
+	Dependencies() []string
 }
 
+// isValidDependencies checks the prerequisite proposal ids of a proposal.
+func isValidDependencies(dependencies []string) error {
+	if len(dependencies) > MaxProposalDependencies {
+		return util.ErrInvalid.Errorf("too many dependencies, %d > %d", len(dependencies), MaxProposalDependencies)
+	}
+
+	founds := map[string]struct{}{}
+	for _, d := range dependencies {
+		if len(d) == 0 {
+			return util.ErrInvalid.Errorf("empty dependency proposal id")
+		}
+
+		if _, found := founds[d]; found {
+			return util.ErrInvalid.Errorf("duplicated dependency proposal id, %q", d)
+		}
+		founds[d] = struct{}{}
+	}
+
+	return nil
+}
+
+// dependenciesBytes prefixes each dependency proposal id with its length, so the ids can not
+// be split in other ways.
+func dependenciesBytes(dependencies []string) []byte {
+	bs := make([][]byte, len(dependencies)*2)
+	for i, d := range dependencies {
+		bs[i*2] = util.Uint64ToBytes(uint64(len(d)))
+		bs[i*2+1] = []byte(d)
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// CryptoProposal is the proposal which runs its call data once completed.
+// An optimistic proposal is completed at post-snap unless the disapproval votes exceed
+// the objection threshold of the policy, and the turnout is not required.
+// The proposal is not executed until all its dependencies are executed. The failure of a
+// dependency does not end the proposal by itself; the proposal is canceled when it is
+// executed after the failure.
 type CryptoProposal struct {
 	hint.BaseHinter
-	proposer  base.Address
-	startTime uint64
-	callData  CallData
+	proposer     base.Address
+	startTime    uint64
+	callData     CallData
+	optimistic   bool
+	dependencies []string
 }
 
-func NewCryptoProposal(proposer base.Address, startTime uint64, callData CallData) CryptoProposal {
+func NewCryptoProposal(
+	proposer base.Address, startTime uint64, callData CallData, optimistic bool, dependencies []string,
+) CryptoProposal {
 	return CryptoProposal{
-		BaseHinter: hint.NewBaseHinter(CryptoProposalHint),
-		proposer:   proposer,
-		startTime:  startTime,
-		callData:   callData,
+		BaseHinter:   hint.NewBaseHinter(CryptoProposalHint),
+		proposer:     proposer,
+		startTime:    startTime,
+		callData:     callData,
+		optimistic:   optimistic,
+		dependencies: dependencies,
 	}
 }
 
@@ -77,12 +126,29 @@ func (CryptoProposal) VoteOptionsCount() uint8 {
 	return 3
 }
 
+// Bytes omits the optimistic flag and the dependencies when they are not set, so the proposals
+// written before them keep their hashes.
 func (p CryptoProposal) Bytes() []byte {
-	return util.ConcatBytesSlice(
+	bs := util.ConcatBytesSlice(
 		p.proposer.Bytes(),
 		util.Uint64ToBytes(p.startTime),
 		p.callData.Bytes(),
 	)
+
+	if !p.optimistic && len(p.dependencies) < 1 {
+		return bs
+	}
+
+	ob := make([]byte, 1)
+	if p.optimistic {
+		ob[0] = 1
+	}
+
+	return util.ConcatBytesSlice(
+		bs,
+		ob,
+		dependenciesBytes(p.dependencies),
+	)
 }
 
 func (p CryptoProposal) Proposer() base.Address {
@@ -97,6 +163,14 @@ func (p CryptoProposal) CallData() CallData {
 	return p.callData
 }
 
+func (p CryptoProposal) Optimistic() bool {
+	return p.optimistic
+}
+
+func (p CryptoProposal) Dependencies() []string {
+	return p.dependencies
+}
+
 func (p CryptoProposal) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		p.BaseHinter,
@@ -106,6 +180,10 @@ func (p CryptoProposal) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid CryptoProposal: %v", err)
 	}
 
+	if err := isValidDependencies(p.dependencies); err != nil {
+		return util.ErrInvalid.Errorf("invalid CryptoProposal: %v", err)
+	}
+
 	return nil
 }
 
@@ -115,21 +193,25 @@ func (p CryptoProposal) Addresses() []base.Address {
 
 type BizProposal struct {
 	hint.BaseHinter
-	proposer  base.Address
-	startTime uint64
-	url       URL
-	hash      string
-	options   uint8
+	proposer     base.Address
+	startTime    uint64
+	url          URL
+	hash         string
+	options      uint8
+	dependencies []string
 }
 
-func NewBizProposal(proposer base.Address, startTime uint64, url URL, hash string, options uint8) BizProposal {
+func NewBizProposal(
+	proposer base.Address, startTime uint64, url URL, hash string, options uint8, dependencies []string,
+) BizProposal {
 	return BizProposal{
-		BaseHinter: hint.NewBaseHinter(BizProposalHint),
-		proposer:   proposer,
-		startTime:  startTime,
-		url:        url,
-		hash:       hash,
-		options:    options,
+		BaseHinter:   hint.NewBaseHinter(BizProposalHint),
+		proposer:     proposer,
+		startTime:    startTime,
+		url:          url,
+		hash:         hash,
+		options:      options,
+		dependencies: dependencies,
 	}
 }
 
@@ -141,14 +223,22 @@ func (p BizProposal) VoteOptionsCount() uint8 {
 	return p.options
 }
 
+// Bytes omits the dependencies when they are not set, so the proposals written before them keep
+// their hashes.
 func (p BizProposal) Bytes() []byte {
-	return util.ConcatBytesSlice(
+	bs := util.ConcatBytesSlice(
 		p.proposer.Bytes(),
 		util.Uint64ToBytes(p.startTime),
 		p.url.Bytes(),
 		[]byte(p.hash),
 		util.Uint8ToBytes(p.options),
 	)
+
+	if len(p.dependencies) < 1 {
+		return bs
+	}
+
+	return util.ConcatBytesSlice(bs, dependenciesBytes(p.dependencies))
 }
 
 func (p BizProposal) Proposer() base.Address {
@@ -167,6 +257,10 @@ func (p BizProposal) Hash() string {
 	return p.hash
 }
 
+func (p BizProposal) Dependencies() []string {
+	return p.dependencies
+}
+
 func (p BizProposal) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		p.BaseHinter,
@@ -184,6 +278,10 @@ func (p BizProposal) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("biz - zero options")
 	}
 
+	if err := isValidDependencies(p.dependencies); err != nil {
+		return util.ErrInvalid.Errorf("invalid BizProposal: %v", err)
+	}
+
 	return nil
 }
 
@@ -191,6 +289,15 @@ func (p BizProposal) Addresses() []base.Address {
 	return []base.Address{}
 }
 
+// IsOptimistic reports whether the proposal is an optimistic crypto proposal.
+func IsOptimistic(proposal Proposal) bool {
+	p, ok := proposal.(CryptoProposal)
+
+	return ok && p.optimistic
+}
+
+// GetPeriodOfCurrentTime returns the lifecycle period of the proposal at nowTime with the start and
+// end of the preferred period. nowTime is in the period unit of the policy, see Policy.PeriodNow.
 func GetPeriodOfCurrentTime(
 	policy Policy,
 	proposal Proposal,
@@ -198,19 +305,28 @@ func GetPeriodOfCurrentTime(
 	nowTime uint64,
 ) (Period, int64 /*period start time*/, int64 /*period end time*/) {
 	startTime := proposal.StartTime()
-	registrationTime := startTime + policy.ProposalReviewPeriod()
+	reviewTime := startTime + policy.DepositPeriod()
+	registrationTime := reviewTime + policy.ProposalReviewPeriod()
 	preSnapTime := registrationTime + policy.RegistrationPeriod()
 	votingTime := preSnapTime + policy.PreSnapshotPeriod()
 	postSnapTime := votingTime + policy.VotingPeriod()
 	executionDelayTime := postSnapTime + policy.PostSnapshotPeriod()
 	executeTime := executionDelayTime + policy.ExecutionDelayPeriod()
 
+	// without execution grace period, completed proposal can be executed at any time.
+	expireTime := uint64(math.MaxUint64)
+	if policy.ExecutionGracePeriod() > 0 {
+		expireTime = executeTime + policy.ExecutionGracePeriod()
+	}
+
 	currentPeriod := NilPeriod
 	preferredStart, preferredEnd := int64(0), int64(0)
 
 	switch {
 	case nowTime < startTime:
 		currentPeriod = PreLifeCycle
+	case nowTime < reviewTime:
+		currentPeriod = DepositCollection
 	case nowTime < registrationTime:
 		currentPeriod = ProposalReview
 	case nowTime < preSnapTime:
@@ -223,15 +339,19 @@ func GetPeriodOfCurrentTime(
 		currentPeriod = PostSnapshot
 	case nowTime < executeTime:
 		currentPeriod = ExecutionDelay
-	case nowTime >= executeTime:
+	case nowTime < expireTime:
 		currentPeriod = Execute
+	case nowTime >= expireTime:
+		currentPeriod = ExecutionExpired
 	}
 
 	switch preferredPeriod {
 	case PreLifeCycle:
 		preferredStart, preferredEnd = 0, int64(startTime)
+	case DepositCollection:
+		preferredStart, preferredEnd = int64(startTime), int64(reviewTime)
 	case ProposalReview:
-		preferredStart, preferredEnd = int64(startTime), int64(registrationTime)
+		preferredStart, preferredEnd = int64(reviewTime), int64(registrationTime)
 	case Registration:
 		preferredStart, preferredEnd = int64(registrationTime), int64(preSnapTime)
 	case PreSnapshot:
@@ -244,6 +364,14 @@ func GetPeriodOfCurrentTime(
 		preferredStart, preferredEnd = int64(executionDelayTime), int64(executeTime)
 	case Execute:
 		preferredStart, preferredEnd = int64(executeTime), math.MaxInt64
+		if policy.ExecutionGracePeriod() > 0 {
+			preferredEnd = int64(expireTime)
+		}
+	case ExecutionExpired:
+		preferredStart, preferredEnd = math.MaxInt64, math.MaxInt64
+		if policy.ExecutionGracePeriod() > 0 {
+			preferredStart = int64(expireTime)
+		}
 	}
 
 	return currentPeriod, preferredStart, preferredEnd
@@ -10,19 +10,23 @@ import (
 func (p CryptoProposal) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":      p.Hint().String(),
-			"proposer":   p.proposer,
-			"start_time": p.startTime,
-			"call_data":  p.callData,
+			"_hint":        p.Hint().String(),
+			"proposer":     p.proposer,
+			"start_time":   p.startTime,
+			"call_data":    p.callData,
+			"optimistic":   p.optimistic,
+			"dependencies": p.dependencies,
 		},
 	)
 }
 
 type CryptoProposalBSONUnmarshaler struct {
-	Hint      string   `bson:"_hint"`
-	Proposer  string   `bson:"proposer"`
-	StartTime uint64   `bson:"start_time"`
-	CallData  bson.Raw `bson:"call_data"`
+	Hint         string   `bson:"_hint"`
+	Proposer     string   `bson:"proposer"`
+	StartTime    uint64   `bson:"start_time"`
+	CallData     bson.Raw `bson:"call_data"`
+	Optimistic   bool     `bson:"optimistic"`
+	Dependencies []string `bson:"dependencies"`
 }
 
 func (p *CryptoProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -38,29 +42,31 @@ func (p *CryptoProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.CallData)
+	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.CallData, up.Optimistic, up.Dependencies)
 }
 
 func (p BizProposal) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":      p.Hint().String(),
-			"proposer":   p.proposer,
-			"start_time": p.startTime,
-			"url":        p.url,
-			"hash":       p.hash,
-			"options":    p.options,
+			"_hint":        p.Hint().String(),
+			"proposer":     p.proposer,
+			"start_time":   p.startTime,
+			"url":          p.url,
+			"hash":         p.hash,
+			"options":      p.options,
+			"dependencies": p.dependencies,
 		},
 	)
 }
 
 type BizProposalBSONUnmarshaler struct {
-	Hint      string `bson:"_hint"`
-	Proposer  string `bson:"proposer"`
-	StartTime uint64 `bson:"start_time"`
-	Url       string `bson:"url"`
-	Hash      string `bson:"hash"`
-	Options   uint8  `bson:"options"`
+	Hint         string   `bson:"_hint"`
+	Proposer     string   `bson:"proposer"`
+	StartTime    uint64   `bson:"start_time"`
+	Url          string   `bson:"url"`
+	Hash         string   `bson:"hash"`
+	Options      uint8    `bson:"options"`
+	Dependencies []string `bson:"dependencies"`
 }
 
 func (p *BizProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -76,5 +82,5 @@ func (p *BizProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options)
+	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options, up.Dependencies)
 }
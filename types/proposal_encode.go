@@ -9,9 +9,11 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (p *CryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, bcd []byte) error {
+func (p *CryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, bcd []byte, op bool, deps []string) error {
 	p.BaseHinter = hint.NewBaseHinter(ht)
 	p.startTime = st
+	p.optimistic = op
+	p.dependencies = deps
 
 	switch a, err := base.DecodeAddress(pr, enc); {
 	case err != nil:
@@ -31,7 +33,7 @@ func (p *CryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st
 	return nil
 }
 
-func (p *BizProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, url, hash string, opt uint8) error {
+func (p *BizProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, url, hash string, opt uint8, deps []string) error {
 	e := util.StringError("failed to unmarshal BizProposal")
 
 	p.BaseHinter = hint.NewBaseHinter(ht)
@@ -40,6 +42,7 @@ func (p *BizProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st ui
 	p.url = URL(url)
 	p.hash = hash
 	p.options = opt
+	p.dependencies = deps
 
 	switch a, err := base.DecodeAddress(pr, enc); {
 	case err != nil:
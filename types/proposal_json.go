@@ -11,25 +11,31 @@ import (
 
 type CryptoProposalJSONMarshaler struct {
 	hint.BaseHinter
-	Proposer  base.Address `json:"proposer"`
-	StartTime uint64       `json:"start_time"`
-	CallData  CallData     `json:"call_data"`
+	Proposer     base.Address `json:"proposer"`
+	StartTime    uint64       `json:"start_time"`
+	CallData     CallData     `json:"call_data"`
+	Optimistic   bool         `json:"optimistic"`
+	Dependencies []string     `json:"dependencies"`
 }
 
 func (p CryptoProposal) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(CryptoProposalJSONMarshaler{
-		BaseHinter: p.BaseHinter,
-		Proposer:   p.proposer,
-		CallData:   p.callData,
-		StartTime:  p.startTime,
+		BaseHinter:   p.BaseHinter,
+		Proposer:     p.proposer,
+		CallData:     p.callData,
+		StartTime:    p.startTime,
+		Optimistic:   p.optimistic,
+		Dependencies: p.dependencies,
 	})
 }
 
 type CryptoProposalJSONUnmarshaler struct {
-	Hint      hint.Hint       `json:"_hint"`
-	Proposer  string          `json:"proposer"`
-	StartTime uint64          `json:"start_time"`
-	CallData  json.RawMessage `json:"call_data"`
+	Hint         hint.Hint       `json:"_hint"`
+	Proposer     string          `json:"proposer"`
+	StartTime    uint64          `json:"start_time"`
+	CallData     json.RawMessage `json:"call_data"`
+	Optimistic   bool            `json:"optimistic"`
+	Dependencies []string        `json:"dependencies"`
 }
 
 func (p *CryptoProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -40,36 +46,39 @@ func (p *CryptoProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.CallData)
+	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.CallData, up.Optimistic, up.Dependencies)
 }
 
 type BizProposalJSONMarshaler struct {
 	hint.BaseHinter
-	Proposer  base.Address `json:"proposer"`
-	StartTime uint64       `json:"start_time"`
-	Url       URL          `json:"url"`
-	Hash      string       `json:"hash"`
-	Options   uint8        `json:"options"`
+	Proposer     base.Address `json:"proposer"`
+	StartTime    uint64       `json:"start_time"`
+	Url          URL          `json:"url"`
+	Hash         string       `json:"hash"`
+	Options      uint8        `json:"options"`
+	Dependencies []string     `json:"dependencies"`
 }
 
 func (p BizProposal) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(BizProposalJSONMarshaler{
-		BaseHinter: p.BaseHinter,
-		Proposer:   p.proposer,
-		StartTime:  p.startTime,
-		Url:        p.url,
-		Hash:       p.hash,
-		Options:    p.options,
+		BaseHinter:   p.BaseHinter,
+		Proposer:     p.proposer,
+		StartTime:    p.startTime,
+		Url:          p.url,
+		Hash:         p.hash,
+		Options:      p.options,
+		Dependencies: p.dependencies,
 	})
 }
 
 type BizProposalJSONUnmarshaler struct {
-	Hint      hint.Hint `json:"_hint"`
-	Proposer  string    `json:"proposer"`
-	StartTime uint64    `json:"start_time"`
-	Url       string    `json:"url"`
-	Hash      string    `json:"hash"`
-	Options   uint8     `json:"options"`
+	Hint         hint.Hint `json:"_hint"`
+	Proposer     string    `json:"proposer"`
+	StartTime    uint64    `json:"start_time"`
+	Url          string    `json:"url"`
+	Hash         string    `json:"hash"`
+	Options      uint8     `json:"options"`
+	Dependencies []string  `json:"dependencies"`
 }
 
 func (p *BizProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -80,5 +89,5 @@ func (p *BizProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options)
+	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options, up.Dependencies)
 }
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var ReasonDetailHint = hint.MustNewHint("mitum-dao-reason-detail-v0.0.1")
+
+// NoWinningOption means that no vote option has won the proposal.
+const NoWinningOption = ^uint8(0)
+
+// ReasonDetail is the machine-readable reason of the proposal status.
+// measuredTurnout and requiredTurnout are set only when the turnout is judged,
+// and tally, the voting result ordered by vote option, only when the votes are counted.
+type ReasonDetail struct {
+	hint.BaseHinter
+	code            ReasonCode
+	measuredTurnout common.Big
+	requiredTurnout common.Big
+	winningOption   uint8
+	tally           []common.Big
+}
+
+func NewReasonDetail(code ReasonCode) ReasonDetail {
+	return ReasonDetail{
+		BaseHinter:      hint.NewBaseHinter(ReasonDetailHint),
+		code:            code,
+		measuredTurnout: common.ZeroBig,
+		requiredTurnout: common.ZeroBig,
+		winningOption:   NoWinningOption,
+		tally:           []common.Big{},
+	}
+}
+
+func (r ReasonDetail) WithTurnout(measured, required common.Big) ReasonDetail {
+	r.measuredTurnout = measured
+	r.requiredTurnout = required
+
+	return r
+}
+
+func (r ReasonDetail) WithTally(winningOption uint8, tally []common.Big) ReasonDetail {
+	r.winningOption = winningOption
+	r.tally = tally
+
+	return r
+}
+
+func (r ReasonDetail) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ReasonDetail")
+
+	if err := r.BaseHinter.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if r.code >= NilReason {
+		return e.Errorf("invalid reason code, %d", r.code)
+	}
+
+	if err := util.CheckIsValiders(nil, false, r.measuredTurnout, r.requiredTurnout); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range r.tally {
+		if err := r.tally[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	if r.winningOption != NoWinningOption && int(r.winningOption) >= len(r.tally) {
+		return e.Errorf("winning option, %d out of tally", r.winningOption)
+	}
+
+	return nil
+}
+
+func (r ReasonDetail) Bytes() []byte {
+	bs := make([][]byte, len(r.tally))
+	for i := range r.tally {
+		bs[i] = r.tally[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		r.code.Bytes(),
+		r.measuredTurnout.Bytes(),
+		r.requiredTurnout.Bytes(),
+		util.Uint8ToBytes(r.winningOption),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (r ReasonDetail) Code() ReasonCode {
+	return r.code
+}
+
+func (r ReasonDetail) MeasuredTurnout() common.Big {
+	return r.measuredTurnout
+}
+
+func (r ReasonDetail) RequiredTurnout() common.Big {
+	return r.requiredTurnout
+}
+
+func (r ReasonDetail) WinningOption() uint8 {
+	return r.winningOption
+}
+
+func (r ReasonDetail) Tally() []common.Big {
+	return r.tally
+}
+
+// SortVotingResult orders the voting result by vote option; options without votes are counted as zero.
+func SortVotingResult(result map[uint8]common.Big, options uint8) []common.Big {
+	tally := make([]common.Big, options)
+	for i := range tally {
+		if v, found := result[uint8(i)]; found {
+			tally[i] = v
+		} else {
+			tally[i] = common.ZeroBig
+		}
+	}
+
+	return tally
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (r ReasonDetail) MarshalBSON() ([]byte, error) {
+	tally := make([]string, len(r.tally))
+	for i := range r.tally {
+		tally[i] = r.tally[i].String()
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":            r.Hint().String(),
+			"code":             r.code,
+			"measured_turnout": r.measuredTurnout.String(),
+			"required_turnout": r.requiredTurnout.String(),
+			"winning_option":   r.winningOption,
+			"tally":            tally,
+		},
+	)
+}
+
+type ReasonDetailBSONUnmarshaler struct {
+	Hint            string   `bson:"_hint"`
+	Code            uint8    `bson:"code"`
+	MeasuredTurnout string   `bson:"measured_turnout"`
+	RequiredTurnout string   `bson:"required_turnout"`
+	WinningOption   uint8    `bson:"winning_option"`
+	Tally           []string `bson:"tally"`
+}
+
+func (r *ReasonDetail) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ReasonDetail")
+
+	var u ReasonDetailBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.unpack(ht, u.Code, u.MeasuredTurnout, u.RequiredTurnout, u.WinningOption, u.Tally); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (r *ReasonDetail) unpack(
+	ht hint.Hint,
+	code uint8,
+	measuredTurnout, requiredTurnout string,
+	winningOption uint8,
+	tally []string,
+) error {
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.code = ReasonCode(code)
+	r.winningOption = winningOption
+
+	big, err := common.NewBigFromString(measuredTurnout)
+	if err != nil {
+		return err
+	}
+	r.measuredTurnout = big
+
+	big, err = common.NewBigFromString(requiredTurnout)
+	if err != nil {
+		return err
+	}
+	r.requiredTurnout = big
+
+	r.tally = make([]common.Big, len(tally))
+	for i := range tally {
+		big, err := common.NewBigFromString(tally[i])
+		if err != nil {
+			return err
+		}
+		r.tally[i] = big
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type ReasonDetailJSONMarshaler struct {
+	hint.BaseHinter
+	Code            ReasonCode   `json:"code"`
+	MeasuredTurnout common.Big   `json:"measured_turnout"`
+	RequiredTurnout common.Big   `json:"required_turnout"`
+	WinningOption   uint8        `json:"winning_option"`
+	Tally           []common.Big `json:"tally"`
+}
+
+func (r ReasonDetail) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReasonDetailJSONMarshaler{
+		BaseHinter:      r.BaseHinter,
+		Code:            r.code,
+		MeasuredTurnout: r.measuredTurnout,
+		RequiredTurnout: r.requiredTurnout,
+		WinningOption:   r.winningOption,
+		Tally:           r.tally,
+	})
+}
+
+type ReasonDetailJSONUnmarshaler struct {
+	Hint            hint.Hint `json:"_hint"`
+	Code            uint8     `json:"code"`
+	MeasuredTurnout string    `json:"measured_turnout"`
+	RequiredTurnout string    `json:"required_turnout"`
+	WinningOption   uint8     `json:"winning_option"`
+	Tally           []string  `json:"tally"`
+}
+
+func (r *ReasonDetail) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ReasonDetail")
+
+	var u ReasonDetailJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.unpack(u.Hint, u.Code, u.MeasuredTurnout, u.RequiredTurnout, u.WinningOption, u.Tally); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
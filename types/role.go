@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/pkg/errors"
+)
+
+// Role is a permission in the dao granted to an account by the admins.
+//
+//   - admin: updates the policy, manages the whitelists, grants or revokes roles and
+//     cancels proposals of other proposers. The owner and the handlers of the contract
+//     account are admins until the admin is renounced; after that nobody holds it.
+//   - proposer: proposes as a whitelisted account.
+//   - executor: pre-snaps, post-snaps and executes proposals with the policy executors.
+//     Once any account holds it or the policy executors are set, nobody else can.
+//   - guardian: vetoes completed security-critical proposals with the policy guardians.
+//   - reviewer: rejects proposals during the review period when the policy has no
+//     reviewers.
+//
+// At most as many accounts as the policy allows for the committee hold the executor,
+// guardian or reviewer role.
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleProposer Role = "proposer"
+	RoleExecutor Role = "executor"
+	RoleGuardian Role = "guardian"
+	RoleReviewer Role = "reviewer"
+)
+
+func (r Role) IsValid([]byte) error {
+	switch r {
+	case RoleAdmin, RoleProposer, RoleExecutor, RoleGuardian, RoleReviewer:
+		return nil
+	default:
+		return common.ErrValueInvalid.Wrap(
+			errors.Errorf("role must be admin, proposer, executor, guardian or reviewer, got %v", r))
+	}
+}
+
+// MaxHolders returns the number of accounts which can hold the role in a dao; zero is
+// unlimited.
+func (r Role) MaxHolders() uint64 {
+	switch r {
+	case RoleExecutor:
+		return MaxExecutors
+	case RoleGuardian:
+		return MaxGuardians
+	case RoleReviewer:
+		return MaxReviewers
+	default:
+		return 0
+	}
+}
+
+func (r Role) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
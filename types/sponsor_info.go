@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var SponsorInfoHint = hint.MustNewHint("mitum-dao-sponsor-info-v0.0.1")
+
+// SponsorInfo is the sponsorship of a whitelisted account to a proposal.
+type SponsorInfo struct {
+	hint.BaseHinter
+	sponsor base.Address
+	height  base.Height
+}
+
+func NewSponsorInfo(sponsor base.Address, height base.Height) SponsorInfo {
+	return SponsorInfo{
+		BaseHinter: hint.NewBaseHinter(SponsorInfoHint),
+		sponsor:    sponsor,
+		height:     height,
+	}
+}
+
+func (r SponsorInfo) Hint() hint.Hint {
+	return r.BaseHinter.Hint()
+}
+
+func (r SponsorInfo) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SponsorInfo")
+
+	if err := r.BaseHinter.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.sponsor.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r SponsorInfo) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		r.sponsor.Bytes(),
+		r.height.Bytes(),
+	)
+}
+
+func (r SponsorInfo) Sponsor() base.Address {
+	return r.sponsor
+}
+
+func (r SponsorInfo) Height() base.Height {
+	return r.height
+}
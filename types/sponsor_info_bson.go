@@ -0,0 +1,51 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (r SponsorInfo) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   r.Hint().String(),
+			"sponsor": r.sponsor,
+			"height":  r.height,
+		},
+	)
+}
+
+type SponsorInfoBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Sponsor string `bson:"sponsor"`
+	Height  int64  `bson:"height"`
+}
+
+func (r *SponsorInfo) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SponsorInfo")
+
+	var u SponsorInfoBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.height = base.Height(u.Height)
+
+	switch a, err := base.DecodeAddress(u.Sponsor, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.sponsor = a
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type SponsorInfoJSONMarshaler struct {
+	hint.BaseHinter
+	Sponsor base.Address `json:"sponsor"`
+	Height  base.Height  `json:"height"`
+}
+
+func (r SponsorInfo) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsorInfoJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Sponsor:    r.sponsor,
+		Height:     r.height,
+	})
+}
+
+type SponsorInfoJSONUnmarshaler struct {
+	Hint    hint.Hint   `json:"_hint"`
+	Sponsor string      `json:"sponsor"`
+	Height  base.Height `json:"height"`
+}
+
+func (r *SponsorInfo) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SponsorInfo")
+
+	var u SponsorInfoJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(u.Hint)
+	r.height = u.Height
+
+	switch a, err := base.DecodeAddress(u.Sponsor, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.sponsor = a
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var StatusTransitionHint = hint.MustNewHint("mitum-dao-status-transition-v0.0.1")
+
+// StatusTransition is one entry of the status history of proposal.
+type StatusTransition struct {
+	hint.BaseHinter
+	from     ProposalStatus
+	to       ProposalStatus
+	height   base.Height
+	factHash util.Hash
+	reason   ReasonCode
+}
+
+func NewStatusTransition(
+	from, to ProposalStatus, height base.Height, factHash util.Hash, reason ReasonCode,
+) StatusTransition {
+	return StatusTransition{
+		BaseHinter: hint.NewBaseHinter(StatusTransitionHint),
+		from:       from,
+		to:         to,
+		height:     height,
+		factHash:   factHash,
+		reason:     reason,
+	}
+}
+
+func (t StatusTransition) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StatusTransition")
+
+	if err := util.CheckIsValiders(nil, false, t.BaseHinter, t.height, t.factHash); err != nil {
+		return e.Wrap(err)
+	}
+
+	if t.to >= NilStatus {
+		return e.Errorf("invalid status, %d", t.to)
+	}
+
+	if t.reason >= NilReason {
+		return e.Errorf("invalid reason code, %d", t.reason)
+	}
+
+	return nil
+}
+
+func (t StatusTransition) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		t.from.Bytes(),
+		t.to.Bytes(),
+		t.height.Bytes(),
+		t.factHash.Bytes(),
+		t.reason.Bytes(),
+	)
+}
+
+func (t StatusTransition) From() ProposalStatus {
+	return t.from
+}
+
+func (t StatusTransition) To() ProposalStatus {
+	return t.to
+}
+
+func (t StatusTransition) Height() base.Height {
+	return t.height
+}
+
+func (t StatusTransition) FactHash() util.Hash {
+	return t.factHash
+}
+
+func (t StatusTransition) Reason() ReasonCode {
+	return t.reason
+}
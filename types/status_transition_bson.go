@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (t StatusTransition) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     t.Hint().String(),
+			"from":      t.from,
+			"to":        t.to,
+			"height":    t.height,
+			"fact_hash": t.factHash.String(),
+			"reason":    t.reason,
+		},
+	)
+}
+
+type StatusTransitionBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	From     uint8  `bson:"from"`
+	To       uint8  `bson:"to"`
+	Height   int64  `bson:"height"`
+	FactHash string `bson:"fact_hash"`
+	Reason   uint8  `bson:"reason"`
+}
+
+func (t *StatusTransition) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of StatusTransition")
+
+	var u StatusTransitionBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	t.BaseHinter = hint.NewBaseHinter(ht)
+	t.from = ProposalStatus(u.From)
+	t.to = ProposalStatus(u.To)
+	t.height = base.Height(u.Height)
+	t.factHash = valuehash.NewBytesFromString(u.FactHash)
+	t.reason = ReasonCode(u.Reason)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+type StatusTransitionJSONMarshaler struct {
+	hint.BaseHinter
+	From     ProposalStatus `json:"from"`
+	To       ProposalStatus `json:"to"`
+	Height   base.Height    `json:"height"`
+	FactHash util.Hash      `json:"fact_hash"`
+	Reason   ReasonCode     `json:"reason"`
+}
+
+func (t StatusTransition) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StatusTransitionJSONMarshaler{
+		BaseHinter: t.BaseHinter,
+		From:       t.from,
+		To:         t.to,
+		Height:     t.height,
+		FactHash:   t.factHash,
+		Reason:     t.reason,
+	})
+}
+
+type StatusTransitionJSONUnmarshaler struct {
+	Hint     hint.Hint             `json:"_hint"`
+	From     uint8                 `json:"from"`
+	To       uint8                 `json:"to"`
+	Height   base.Height           `json:"height"`
+	FactHash valuehash.HashDecoder `json:"fact_hash"`
+	Reason   uint8                 `json:"reason"`
+}
+
+func (t *StatusTransition) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of StatusTransition")
+
+	var u StatusTransitionJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	t.BaseHinter = hint.NewBaseHinter(u.Hint)
+	t.from = ProposalStatus(u.From)
+	t.to = ProposalStatus(u.To)
+	t.height = u.Height
+	t.factHash = u.FactHash.Hash()
+	t.reason = ReasonCode(u.Reason)
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var TallyHint = hint.MustNewHint("mitum-dao-tally-v0.0.1")
+
+// Tally keeps the inputs and outputs of the post-snap decision of the proposal,
+// so that the outcome can be verified without re-deriving the policy arithmetic.
+// turnout and turnoutCount are zero when the turnout is waived for an optimistic proposal, and
+// objectionThreshold and objectionCount are zero for a proposal which is not optimistic.
+type Tally struct {
+	hint.BaseHinter
+	totalSupply        common.Big
+	turnout            PercentRatio
+	turnoutCount       common.Big
+	votedTotal         common.Big
+	registeredTotal    common.Big
+	quorum             PercentRatio
+	quorumCount        common.Big
+	objectionThreshold PercentRatio
+	objectionCount     common.Big
+	result             []common.Big
+	outcome            ProposalStatus
+}
+
+func NewTally(
+	totalSupply common.Big,
+	turnout PercentRatio,
+	turnoutCount common.Big,
+	votedTotal common.Big,
+	registeredTotal common.Big,
+	quorum PercentRatio,
+	quorumCount common.Big,
+	objectionThreshold PercentRatio,
+	objectionCount common.Big,
+	result []common.Big,
+	outcome ProposalStatus,
+) Tally {
+	return Tally{
+		BaseHinter:         hint.NewBaseHinter(TallyHint),
+		totalSupply:        totalSupply,
+		turnout:            turnout,
+		turnoutCount:       turnoutCount,
+		votedTotal:         votedTotal,
+		registeredTotal:    registeredTotal,
+		quorum:             quorum,
+		quorumCount:        quorumCount,
+		objectionThreshold: objectionThreshold,
+		objectionCount:     objectionCount,
+		result:             result,
+		outcome:            outcome,
+	}
+}
+
+func (t Tally) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid Tally")
+
+	if err := util.CheckIsValiders(nil, false,
+		t.BaseHinter,
+		t.totalSupply,
+		t.turnoutCount,
+		t.votedTotal,
+		t.registeredTotal,
+		t.quorum,
+		t.quorumCount,
+		t.objectionCount,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, r := range []PercentRatio{t.turnout, t.objectionThreshold} {
+		if r == 0 {
+			continue
+		}
+
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	for i := range t.result {
+		if err := t.result[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	if t.outcome >= NilStatus {
+		return e.Errorf("invalid outcome status, %d", t.outcome)
+	}
+
+	return nil
+}
+
+func (t Tally) Bytes() []byte {
+	bs := make([][]byte, len(t.result))
+	for i := range t.result {
+		bs[i] = t.result[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		t.totalSupply.Bytes(),
+		t.turnout.Bytes(),
+		t.turnoutCount.Bytes(),
+		t.votedTotal.Bytes(),
+		t.registeredTotal.Bytes(),
+		t.quorum.Bytes(),
+		t.quorumCount.Bytes(),
+		t.objectionThreshold.Bytes(),
+		t.objectionCount.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		t.outcome.Bytes(),
+	)
+}
+
+func (t Tally) TotalSupply() common.Big {
+	return t.totalSupply
+}
+
+func (t Tally) Turnout() PercentRatio {
+	return t.turnout
+}
+
+// TurnoutWaived reports whether the turnout was waived, not judged, for the proposal.
+func (t Tally) TurnoutWaived() bool {
+	return t.turnout == 0
+}
+
+func (t Tally) TurnoutCount() common.Big {
+	return t.turnoutCount
+}
+
+func (t Tally) VotedTotal() common.Big {
+	return t.votedTotal
+}
+
+func (t Tally) RegisteredTotal() common.Big {
+	return t.registeredTotal
+}
+
+func (t Tally) Quorum() PercentRatio {
+	return t.quorum
+}
+
+func (t Tally) QuorumCount() common.Big {
+	return t.quorumCount
+}
+
+func (t Tally) ObjectionThreshold() PercentRatio {
+	return t.objectionThreshold
+}
+
+func (t Tally) ObjectionCount() common.Big {
+	return t.objectionCount
+}
+
+func (t Tally) Result() []common.Big {
+	return t.result
+}
+
+func (t Tally) Outcome() ProposalStatus {
+	return t.outcome
+}
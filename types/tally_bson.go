@@ -0,0 +1,80 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (t Tally) MarshalBSON() ([]byte, error) {
+	result := make([]string, len(t.result))
+	for i := range t.result {
+		result[i] = t.result[i].String()
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":               t.Hint().String(),
+			"total_supply":        t.totalSupply.String(),
+			"turnout":             t.turnout,
+			"turnout_count":       t.turnoutCount.String(),
+			"voted_total":         t.votedTotal.String(),
+			"registered_total":    t.registeredTotal.String(),
+			"quorum":              t.quorum,
+			"quorum_count":        t.quorumCount.String(),
+			"objection_threshold": t.objectionThreshold,
+			"objection_count":     t.objectionCount.String(),
+			"result":              result,
+			"outcome":             t.outcome,
+		},
+	)
+}
+
+type TallyBSONUnmarshaler struct {
+	Hint               string   `bson:"_hint"`
+	TotalSupply        string   `bson:"total_supply"`
+	Turnout            uint     `bson:"turnout"`
+	TurnoutCount       string   `bson:"turnout_count"`
+	VotedTotal         string   `bson:"voted_total"`
+	RegisteredTotal    string   `bson:"registered_total"`
+	Quorum             uint     `bson:"quorum"`
+	QuorumCount        string   `bson:"quorum_count"`
+	ObjectionThreshold uint     `bson:"objection_threshold"`
+	ObjectionCount     string   `bson:"objection_count"`
+	Result             []string `bson:"result"`
+	Outcome            uint8    `bson:"outcome"`
+}
+
+func (t *Tally) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Tally")
+
+	var u TallyBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := t.unpack(
+		ht,
+		u.TotalSupply,
+		u.Turnout,
+		u.TurnoutCount,
+		u.VotedTotal,
+		u.RegisteredTotal,
+		u.Quorum,
+		u.QuorumCount,
+		u.ObjectionThreshold,
+		u.ObjectionCount,
+		u.Result,
+		u.Outcome,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
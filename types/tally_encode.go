@@ -0,0 +1,72 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (t *Tally) unpack(
+	ht hint.Hint,
+	totalSupply string,
+	turnout uint,
+	turnoutCount, votedTotal, registeredTotal string,
+	quorum uint,
+	quorumCount string,
+	objectionThreshold uint,
+	objectionCount string,
+	result []string,
+	outcome uint8,
+) error {
+	t.BaseHinter = hint.NewBaseHinter(ht)
+	t.turnout = PercentRatio(turnout)
+	t.quorum = PercentRatio(quorum)
+	t.objectionThreshold = PercentRatio(objectionThreshold)
+	t.outcome = ProposalStatus(outcome)
+
+	big, err := common.NewBigFromString(totalSupply)
+	if err != nil {
+		return err
+	}
+	t.totalSupply = big
+
+	big, err = common.NewBigFromString(turnoutCount)
+	if err != nil {
+		return err
+	}
+	t.turnoutCount = big
+
+	big, err = common.NewBigFromString(votedTotal)
+	if err != nil {
+		return err
+	}
+	t.votedTotal = big
+
+	big, err = common.NewBigFromString(registeredTotal)
+	if err != nil {
+		return err
+	}
+	t.registeredTotal = big
+
+	big, err = common.NewBigFromString(quorumCount)
+	if err != nil {
+		return err
+	}
+	t.quorumCount = big
+
+	big, err = common.NewBigFromString(objectionCount)
+	if err != nil {
+		return err
+	}
+	t.objectionCount = big
+
+	t.result = make([]common.Big, len(result))
+	for i := range result {
+		big, err := common.NewBigFromString(result[i])
+		if err != nil {
+			return err
+		}
+		t.result[i] = big
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type TallyJSONMarshaler struct {
+	hint.BaseHinter
+	TotalSupply        common.Big     `json:"total_supply"`
+	Turnout            PercentRatio   `json:"turnout"`
+	TurnoutCount       common.Big     `json:"turnout_count"`
+	VotedTotal         common.Big     `json:"voted_total"`
+	RegisteredTotal    common.Big     `json:"registered_total"`
+	Quorum             PercentRatio   `json:"quorum"`
+	QuorumCount        common.Big     `json:"quorum_count"`
+	ObjectionThreshold PercentRatio   `json:"objection_threshold"`
+	ObjectionCount     common.Big     `json:"objection_count"`
+	Result             []common.Big   `json:"result"`
+	Outcome            ProposalStatus `json:"outcome"`
+}
+
+func (t Tally) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TallyJSONMarshaler{
+		BaseHinter:         t.BaseHinter,
+		TotalSupply:        t.totalSupply,
+		Turnout:            t.turnout,
+		TurnoutCount:       t.turnoutCount,
+		VotedTotal:         t.votedTotal,
+		RegisteredTotal:    t.registeredTotal,
+		Quorum:             t.quorum,
+		QuorumCount:        t.quorumCount,
+		ObjectionThreshold: t.objectionThreshold,
+		ObjectionCount:     t.objectionCount,
+		Result:             t.result,
+		Outcome:            t.outcome,
+	})
+}
+
+type TallyJSONUnmarshaler struct {
+	Hint               hint.Hint `json:"_hint"`
+	TotalSupply        string    `json:"total_supply"`
+	Turnout            uint      `json:"turnout"`
+	TurnoutCount       string    `json:"turnout_count"`
+	VotedTotal         string    `json:"voted_total"`
+	RegisteredTotal    string    `json:"registered_total"`
+	Quorum             uint      `json:"quorum"`
+	QuorumCount        string    `json:"quorum_count"`
+	ObjectionThreshold uint      `json:"objection_threshold"`
+	ObjectionCount     string    `json:"objection_count"`
+	Result             []string  `json:"result"`
+	Outcome            uint8     `json:"outcome"`
+}
+
+func (t *Tally) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Tally")
+
+	var u TallyJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := t.unpack(
+		u.Hint,
+		u.TotalSupply,
+		u.Turnout,
+		u.TurnoutCount,
+		u.VotedTotal,
+		u.RegisteredTotal,
+		u.Quorum,
+		u.QuorumCount,
+		u.ObjectionThreshold,
+		u.ObjectionCount,
+		u.Result,
+		u.Outcome,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var VetoInfoHint = hint.MustNewHint("mitum-dao-veto-info-v0.0.1")
+
+const MaxVetoReasonLen = 256
+
+type VetoInfo struct {
+	hint.BaseHinter
+	guardian base.Address
+	reason   string
+}
+
+func NewVetoInfo(guardian base.Address, reason string) VetoInfo {
+	return VetoInfo{
+		BaseHinter: hint.NewBaseHinter(VetoInfoHint),
+		guardian:   guardian,
+		reason:     reason,
+	}
+}
+
+func (r VetoInfo) Hint() hint.Hint {
+	return r.BaseHinter.Hint()
+}
+
+func (r VetoInfo) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid VetoInfo")
+
+	if err := r.BaseHinter.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.guardian.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r VetoInfo) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		r.guardian.Bytes(),
+		[]byte(r.reason),
+	)
+}
+
+func (r VetoInfo) Guardian() base.Address {
+	return r.guardian
+}
+
+func (r VetoInfo) Reason() string {
+	return r.reason
+}
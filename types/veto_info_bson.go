@@ -0,0 +1,51 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (r VetoInfo) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    r.Hint().String(),
+			"guardian": r.guardian,
+			"reason":   r.reason,
+		},
+	)
+}
+
+type VetoInfoBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Guardian string `bson:"guardian"`
+	Reason   string `bson:"reason"`
+}
+
+func (r *VetoInfo) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of VetoInfo")
+
+	var u VetoInfoBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.reason = u.Reason
+
+	switch a, err := base.DecodeAddress(u.Guardian, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.guardian = a
+	}
+
+	return nil
+}
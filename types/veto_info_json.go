@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type VetoInfoJSONMarshaler struct {
+	hint.BaseHinter
+	Guardian base.Address `json:"guardian"`
+	Reason   string       `json:"reason"`
+}
+
+func (r VetoInfo) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VetoInfoJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Guardian:   r.guardian,
+		Reason:     r.reason,
+	})
+}
+
+type VetoInfoJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Guardian string    `json:"guardian"`
+	Reason   string    `json:"reason"`
+}
+
+func (r *VetoInfo) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of VetoInfo")
+
+	var u VetoInfoJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(u.Hint)
+	r.reason = u.Reason
+
+	switch a, err := base.DecodeAddress(u.Guardian, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.guardian = a
+	}
+
+	return nil
+}